@@ -15,12 +15,29 @@ const (
 
 type Config struct {
 	Database DatabaseConfig
-	Server   struct {
+	Pricing  struct {
+		// SaleCombinePolicy is best_of, stack or exclusive.
+		SaleCombinePolicy string `yaml:"sale_combine_policy" mapstructure:"sale_combine_policy"`
+	} `yaml:"pricing" mapstructure:"pricing"`
+	Products struct {
+		// DeletePolicy is restrict, block_sales or delete_sales.
+		DeletePolicy string `yaml:"delete_policy" mapstructure:"delete_policy"`
+	} `yaml:"products" mapstructure:"products"`
+	Retention struct {
+		// DeletedDays is how long deleted products and sales are kept before they are purged.
+		// Zero keeps them until they are purged by hand.
+		DeletedDays int `yaml:"deleted_days" mapstructure:"deleted_days"`
+	} `yaml:"retention" mapstructure:"retention"`
+	Server struct {
 		Host           string `yaml:"host" mapstructure:"host"`
 		GrpcPort       int    `yaml:"grpc_port" mapstructure:"grpc_port"`
 		RequestTimeout int    `yaml:"request_timeout" mapstructure:"request_timeout"`
 		InsertDuration int    `yaml:"insert_duration" mapstructure:"insert_duration"`
 		PathToData     string `yaml:"path_to_data" mapstructure:"path_to_data"`
+		// Background worker intervals, in seconds.
+		PriceSchedulerInterval int `yaml:"price_scheduler_interval" mapstructure:"price_scheduler_interval"`
+		SaleSchedulerInterval  int `yaml:"sale_scheduler_interval" mapstructure:"sale_scheduler_interval"`
+		PurgeSchedulerInterval int `yaml:"purge_scheduler_interval" mapstructure:"purge_scheduler_interval"`
 	} `yaml:"server" mapstructure:"server"`
 }
 
@@ -29,6 +46,8 @@ type DatabaseConfig struct {
 	Uri                string `yaml:"uri" mapstructure:"uri"`
 	Database           string `yaml:"database" mapstructure:"database"`
 	MigrationsPath     string `yaml:"migrations_path" mapstructure:"migrations_path"`
+	TextSearchLanguage string `yaml:"text_search_language" mapstructure:"text_search_language"`
+	PriceCurrency      string `yaml:"price_currency" mapstructure:"price_currency"`
 	*options.ClientOptions
 }
 
@@ -0,0 +1,68 @@
+package grpc
+
+import (
+	"context"
+	iims_pb "github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type attributeServer struct {
+	iims_pb.UnimplementedAttributeServiceServer
+	Logger           zerolog.Logger
+	AttributeService service.AttributeService
+}
+
+func RegisterAttributeServer(server *grpc.Server, logger zerolog.Logger, attributeService service.AttributeService) {
+	iims_pb.RegisterAttributeServiceServer(server, &attributeServer{Logger: logger, AttributeService: attributeService})
+}
+
+func (s *attributeServer) InsertOne(ctx context.Context, req *iims_pb.InsertAttributeRequest) (*iims_pb.InsertAttributeResponse, error) {
+	s.Logger.Debug().Msg("Insert Attribute")
+
+	result, err := s.AttributeService.InsertOne(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("AttributeService InsertOne error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *attributeServer) Get(ctx context.Context, req *iims_pb.GetAttributesRequest) (*iims_pb.GetAttributesResponse, error) {
+	s.Logger.Debug().Msg("Get Attribute")
+
+	result, err := s.AttributeService.Get(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("AttributeService Get error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *attributeServer) Update(ctx context.Context, req *iims_pb.UpdateAttributeRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Update Attribute")
+
+	err := s.AttributeService.Update(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("AttributeService Update error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *attributeServer) Delete(ctx context.Context, req *iims_pb.DeleteAttributeRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Delete Attribute")
+
+	err := s.AttributeService.Delete(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("AttributeService Delete error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
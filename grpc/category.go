@@ -0,0 +1,104 @@
+package grpc
+
+import (
+	"context"
+	iims_pb "github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type categoryServer struct {
+	iims_pb.UnimplementedCategoryServiceServer
+	Logger          zerolog.Logger
+	CategoryService service.CategoryService
+}
+
+func RegisterCategoryServer(server *grpc.Server, logger zerolog.Logger, categoryService service.CategoryService) {
+	iims_pb.RegisterCategoryServiceServer(server, &categoryServer{Logger: logger, CategoryService: categoryService})
+}
+
+func (s *categoryServer) InsertOne(ctx context.Context, req *iims_pb.InsertCategoryRequest) (*iims_pb.InsertCategoryResponse, error) {
+	s.Logger.Debug().Msg("Insert Category")
+
+	result, err := s.CategoryService.InsertOne(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CategoryService InsertOne error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *categoryServer) Get(ctx context.Context, req *iims_pb.GetCategoriesRequest) (*iims_pb.GetCategoriesResponse, error) {
+	s.Logger.Debug().Msg("Get Category")
+
+	result, err := s.CategoryService.Get(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CategoryService Get error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *categoryServer) GetById(ctx context.Context, req *iims_pb.GetByIdCategoryRequest) (*iims_pb.GetCategoryMessage, error) {
+	s.Logger.Debug().Msg("Get Category")
+
+	result, err := s.CategoryService.GetById(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CategoryService GetById error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *categoryServer) Delete(ctx context.Context, req *iims_pb.DeleteCategoryRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Delete Category")
+
+	err := s.CategoryService.Delete(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CategoryService Delete error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *categoryServer) Update(ctx context.Context, req *iims_pb.UpdateCategoryRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Update Category")
+
+	err := s.CategoryService.Update(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CategoryService Update error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *categoryServer) GetTree(ctx context.Context, req *iims_pb.GetCategoryTreeRequest) (*iims_pb.GetCategoryTreeResponse, error) {
+	s.Logger.Debug().Msg("Get Category Tree")
+
+	result, err := s.CategoryService.GetTree(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CategoryService GetTree error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *categoryServer) MoveCategory(ctx context.Context, req *iims_pb.MoveCategoryRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Move Category")
+
+	err := s.CategoryService.MoveCategory(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CategoryService MoveCategory error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,67 @@
+package grpc
+
+import (
+	"context"
+	iims_pb "github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+)
+
+type couponServer struct {
+	iims_pb.UnimplementedCouponServiceServer
+	Logger        zerolog.Logger
+	CouponService service.CouponService
+}
+
+func RegisterCouponServer(server *grpc.Server, logger zerolog.Logger, couponService service.CouponService) {
+	iims_pb.RegisterCouponServiceServer(server, &couponServer{Logger: logger, CouponService: couponService})
+}
+
+func (s *couponServer) InsertOne(ctx context.Context, req *iims_pb.InsertCouponRequest) (*iims_pb.InsertCouponResponse, error) {
+	s.Logger.Debug().Msg("Insert Coupon")
+
+	result, err := s.CouponService.InsertOne(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CouponService InsertOne error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *couponServer) Generate(ctx context.Context, req *iims_pb.GenerateCouponsRequest) (*iims_pb.GenerateCouponsResponse, error) {
+	s.Logger.Debug().Msg("Generate Coupons")
+
+	result, err := s.CouponService.Generate(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CouponService Generate error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *couponServer) ValidateCoupon(ctx context.Context, req *iims_pb.CouponCodeRequest) (*iims_pb.ValidateCouponResponse, error) {
+	s.Logger.Debug().Msg("Validate Coupon")
+
+	result, err := s.CouponService.ValidateCoupon(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CouponService ValidateCoupon error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *couponServer) RedeemCoupon(ctx context.Context, req *iims_pb.CouponCodeRequest) (*iims_pb.RedeemCouponResponse, error) {
+	s.Logger.Debug().Msg("Redeem Coupon")
+
+	result, err := s.CouponService.RedeemCoupon(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("CouponService RedeemCoupon error")
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+const errorDomain = "stocky_iims"
+
+var errorKinds = []struct {
+	kind   error
+	code   codes.Code
+	reason string
+}{
+	{models.ErrNotFound, codes.NotFound, "NOT_FOUND"},
+	{models.ErrInvalidArgument, codes.InvalidArgument, "INVALID_ARGUMENT"},
+	{models.ErrConflict, codes.AlreadyExists, "CONFLICT"},
+	{models.ErrBlocked, codes.FailedPrecondition, "BLOCKED"},
+	{models.ErrPreconditionFailed, codes.FailedPrecondition, "PRECONDITION_FAILED"},
+}
+
+// statusError converts a domain error into a gRPC status. The status carries an ErrorInfo with
+// the kind as reason and the domain error as metadata, plus the details matching the code:
+// field violations for a failed validation, for instance. Errors of no kind are internal.
+func statusError(err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	message := err.Error()
+	var domainErr *models.Error
+	if errors.As(err, &domainErr) {
+		message = domainErr.Message
+	}
+
+	for _, kind := range errorKinds {
+		if !errors.Is(err, kind.kind) {
+			continue
+		}
+
+		details := []protoadapt.MessageV1{&errdetails.ErrorInfo{
+			Reason:   kind.reason,
+			Domain:   errorDomain,
+			Metadata: map[string]string{"error": message},
+		}}
+		switch kind.code {
+		case codes.InvalidArgument:
+			var validationErr *models.ValidationError
+			if errors.As(err, &validationErr) {
+				badRequest := &errdetails.BadRequest{}
+				for _, violation := range validationErr.Violations {
+					badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+						Field:       violation.Field,
+						Description: violation.Description,
+					})
+				}
+				details = append(details, badRequest)
+			}
+		case codes.NotFound:
+			details = append(details, &errdetails.ResourceInfo{Description: err.Error()})
+		case codes.FailedPrecondition:
+			details = append(details, &errdetails.PreconditionFailure{
+				Violations: []*errdetails.PreconditionFailure_Violation{{Type: kind.reason, Description: err.Error()}},
+			})
+		}
+
+		result, detailsErr := status.New(kind.code, err.Error()).WithDetails(details...)
+		if detailsErr != nil {
+			return status.Error(kind.code, err.Error())
+		}
+		return result.Err()
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,33 @@
+package grpc
+
+import (
+	"context"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrorInterceptor turns the errors handlers return into gRPC statuses, see statusError.
+func ErrorInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return nil, statusError(err)
+		}
+
+		return resp, nil
+	}
+}
+
+// ValidationInterceptor rejects requests that break requestRules before they reach a handler,
+// with the field violations in a BadRequest detail.
+func ValidationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if message, ok := req.(proto.Message); ok {
+			if err := validate(message); err != nil {
+				return nil, statusError(err)
+			}
+		}
+
+		return handler(ctx, req)
+	}
+}
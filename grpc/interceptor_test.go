@@ -0,0 +1,98 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/igntnk/stocky_iims/service"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"testing"
+)
+
+func errorInfo(st *status.Status) *errdetails.ErrorInfo {
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			return info
+		}
+	}
+
+	return nil
+}
+
+func TestErrorInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{"not found", repository.ErrEntityNotFound, codes.NotFound, "NOT_FOUND"},
+		{"invalid id", repository.ErrInvalidId, codes.InvalidArgument, "INVALID_ARGUMENT"},
+		{"already exists", repository.ErrAlreadyExists, codes.AlreadyExists, "CONFLICT"},
+		{"insufficient stock", repository.ErrInsufficientStock, codes.FailedPrecondition, "PRECONDITION_FAILED"},
+		{"transfer status", repository.ErrTransferStatus, codes.FailedPrecondition, "PRECONDITION_FAILED"},
+		{"invalid page token", repository.ErrInvalidPageToken, codes.InvalidArgument, "INVALID_ARGUMENT"},
+		{"redemption limit", repository.ErrRedemptionLimit, codes.FailedPrecondition, "PRECONDITION_FAILED"},
+		{"invalid quantity", service.ErrInvalidQuantity, codes.InvalidArgument, "INVALID_ARGUMENT"},
+		{"warehouse blocked", service.ErrWarehouseBlocked, codes.FailedPrecondition, "BLOCKED"},
+		{"product blocked", service.ErrProductBlocked, codes.FailedPrecondition, "BLOCKED"},
+		{"category not empty", service.ErrCategoryNotEmpty, codes.FailedPrecondition, "PRECONDITION_FAILED"},
+		{"product has sales", service.ErrProductHasSales, codes.FailedPrecondition, "PRECONDITION_FAILED"},
+		{"not deleted", service.ErrNotDeleted, codes.FailedPrecondition, "PRECONDITION_FAILED"},
+		{"wrapped", fmt.Errorf("%w: percentage must be between 1 and 100", service.ErrInvalidDiscount), codes.InvalidArgument, "INVALID_ARGUMENT"},
+		{"unknown", errors.New("connection reset"), codes.Internal, ""},
+		{"canceled", context.Canceled, codes.Canceled, ""},
+		{"deadline", context.DeadlineExceeded, codes.DeadlineExceeded, ""},
+		{"status", status.Error(codes.Unavailable, "down"), codes.Unavailable, ""},
+	}
+
+	interceptor := ErrorInterceptor()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			handler := func(context.Context, any) (any, error) { return nil, test.err }
+
+			resp, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+			if resp != nil {
+				t.Fatalf("response = %v, want nil", resp)
+			}
+
+			st, ok := status.FromError(err)
+			if !ok {
+				t.Fatalf("error %v is not a status", err)
+			}
+			if st.Code() != test.code {
+				t.Errorf("code = %v, want %v", st.Code(), test.code)
+			}
+
+			info := errorInfo(st)
+			if test.reason == "" {
+				if info != nil {
+					t.Errorf("unexpected ErrorInfo %v", info)
+				}
+				return
+			}
+			if info == nil {
+				t.Fatal("ErrorInfo is missing")
+			}
+			if info.GetReason() != test.reason {
+				t.Errorf("reason = %q, want %q", info.GetReason(), test.reason)
+			}
+			if info.GetDomain() != errorDomain {
+				t.Errorf("domain = %q, want %q", info.GetDomain(), errorDomain)
+			}
+		})
+	}
+}
+
+func TestErrorInterceptorPassesResponse(t *testing.T) {
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	resp, err := ErrorInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{}, handler)
+	if err != nil || resp != "ok" {
+		t.Fatalf("got (%v, %v), want (ok, nil)", resp, err)
+	}
+}
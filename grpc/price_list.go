@@ -0,0 +1,92 @@
+package grpc
+
+import (
+	"context"
+	iims_pb "github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type priceListServer struct {
+	iims_pb.UnimplementedPriceListServiceServer
+	Logger           zerolog.Logger
+	PriceListService service.PriceListService
+}
+
+func RegisterPriceListServer(server *grpc.Server, logger zerolog.Logger, priceListService service.PriceListService) {
+	iims_pb.RegisterPriceListServiceServer(server, &priceListServer{Logger: logger, PriceListService: priceListService})
+}
+
+func (s *priceListServer) InsertOne(ctx context.Context, req *iims_pb.InsertPriceListRequest) (*iims_pb.InsertPriceListResponse, error) {
+	s.Logger.Debug().Msg("Insert Price List")
+
+	result, err := s.PriceListService.InsertOne(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("PriceListService InsertOne error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *priceListServer) Get(ctx context.Context, req *iims_pb.GetPriceListsRequest) (*iims_pb.GetPriceListsResponse, error) {
+	s.Logger.Debug().Msg("Get Price List")
+
+	result, err := s.PriceListService.Get(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("PriceListService Get error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *priceListServer) GetById(ctx context.Context, req *iims_pb.GetByIdPriceListRequest) (*iims_pb.GetPriceListMessage, error) {
+	s.Logger.Debug().Msg("Get Price List")
+
+	result, err := s.PriceListService.GetById(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("PriceListService GetById error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *priceListServer) Update(ctx context.Context, req *iims_pb.UpdatePriceListRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Update Price List")
+
+	err := s.PriceListService.Update(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("PriceListService Update error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *priceListServer) Delete(ctx context.Context, req *iims_pb.DeletePriceListRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Delete Price List")
+
+	err := s.PriceListService.Delete(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("PriceListService Delete error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *priceListServer) ResolvePrice(ctx context.Context, req *iims_pb.ResolvePriceRequest) (*iims_pb.ResolvePriceResponse, error) {
+	s.Logger.Debug().Msg("Resolve Price")
+
+	result, err := s.PriceListService.ResolvePrice(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("PriceListService ResolvePrice error")
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -60,7 +60,31 @@ func (s *productServer) GetByProductCode(ctx context.Context, req *iims_pb.GetBy
 
 	result, err := s.ProductService.GetByProductCode(ctx, req)
 	if err != nil {
-		s.Logger.Error().Err(err).Msg("ProductService GetById error")
+		s.Logger.Error().Err(err).Msg("ProductService GetByProductCode error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *productServer) GetByBarcode(ctx context.Context, req *iims_pb.GetByBarcodeRequest) (*iims_pb.GetProductMessage, error) {
+	s.Logger.Debug().Msg("Get Product")
+
+	result, err := s.ProductService.GetByBarcode(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService GetByBarcode error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *productServer) SearchProducts(ctx context.Context, req *iims_pb.SearchProductsRequest) (*iims_pb.SearchProductsResponse, error) {
+	s.Logger.Debug().Msg("Search Products")
+
+	result, err := s.ProductService.SearchProducts(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService SearchProducts error")
 		return nil, err
 	}
 
@@ -79,6 +103,30 @@ func (s *productServer) Delete(ctx context.Context, req *iims_pb.DeleteProductRe
 	return &emptypb.Empty{}, nil
 }
 
+func (s *productServer) Restore(ctx context.Context, req *iims_pb.RestoreProductRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Restore Product")
+
+	err := s.ProductService.Restore(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService Restore error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *productServer) Purge(ctx context.Context, req *iims_pb.PurgeProductRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Purge Product")
+
+	err := s.ProductService.Purge(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService Purge error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *productServer) Update(ctx context.Context, req *iims_pb.UpdateProductRequest) (*emptypb.Empty, error) {
 	s.Logger.Debug().Msg("Update Product")
 
@@ -113,3 +161,75 @@ func (s *productServer) UnblockProduct(ctx context.Context, req *iims_pb.BlockPr
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *productServer) GetAvailability(ctx context.Context, req *iims_pb.GetAvailabilityRequest) (*iims_pb.ProductAvailabilityMessage, error) {
+	s.Logger.Debug().Msg("Get Product Availability")
+
+	result, err := s.ProductService.GetAvailability(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService GetAvailability error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *productServer) InsertVariant(ctx context.Context, req *iims_pb.InsertVariantRequest) (*iims_pb.InsertVariantResponse, error) {
+	s.Logger.Debug().Msg("Insert Product Variant")
+
+	result, err := s.ProductService.InsertVariant(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService InsertVariant error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *productServer) UpdateVariant(ctx context.Context, req *iims_pb.UpdateVariantRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Update Product Variant")
+
+	err := s.ProductService.UpdateVariant(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService UpdateVariant error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *productServer) DeleteVariant(ctx context.Context, req *iims_pb.DeleteVariantRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Delete Product Variant")
+
+	err := s.ProductService.DeleteVariant(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService DeleteVariant error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *productServer) SchedulePriceChange(ctx context.Context, req *iims_pb.SchedulePriceChangeRequest) (*iims_pb.PriceChangeMessage, error) {
+	s.Logger.Debug().Msg("Schedule Product Price Change")
+
+	result, err := s.ProductService.SchedulePriceChange(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService SchedulePriceChange error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *productServer) GetPriceAt(ctx context.Context, req *iims_pb.GetPriceAtRequest) (*iims_pb.PriceChangeMessage, error) {
+	s.Logger.Debug().Msg("Get Product Price At")
+
+	result, err := s.ProductService.GetPriceAt(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("ProductService GetPriceAt error")
+		return nil, err
+	}
+
+	return result, nil
+}
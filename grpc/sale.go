@@ -55,6 +55,30 @@ func (s *saleServer) Delete(ctx context.Context, req *iims_pb.DeleteSaleRequest)
 	return &emptypb.Empty{}, nil
 }
 
+func (s *saleServer) Restore(ctx context.Context, req *iims_pb.RestoreSaleRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Restore Sale")
+
+	err := s.SaleService.Restore(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("SaleService Restore error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *saleServer) Purge(ctx context.Context, req *iims_pb.PurgeSaleRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Purge Sale")
+
+	err := s.SaleService.Purge(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("SaleService Purge error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
 func (s *saleServer) Update(ctx context.Context, req *iims_pb.UpdateSaleRequest) (*emptypb.Empty, error) {
 	s.Logger.Debug().Msg("Update Sale")
 
@@ -89,3 +113,27 @@ func (s *saleServer) UnblockSale(ctx context.Context, req *iims_pb.BlockSaleOper
 	}
 	return &emptypb.Empty{}, nil
 }
+
+func (s *saleServer) CalculatePrice(ctx context.Context, req *iims_pb.CalculatePriceRequest) (*iims_pb.CalculatePriceResponse, error) {
+	s.Logger.Debug().Msg("Calculate Price")
+
+	result, err := s.SaleService.CalculatePrice(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("SaleService CalculatePrice error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *saleServer) ListSalesForProduct(ctx context.Context, req *iims_pb.ListSalesForProductRequest) (*iims_pb.GetSalesResponse, error) {
+	s.Logger.Debug().Msg("List Sales For Product")
+
+	result, err := s.SaleService.ListSalesForProduct(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("SaleService ListSalesForProduct error")
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,91 @@
+package grpc
+
+import (
+	"context"
+	iims_pb "github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+)
+
+type stockServer struct {
+	iims_pb.UnimplementedStockServiceServer
+	Logger       zerolog.Logger
+	StockService service.StockService
+}
+
+func RegisterStockServer(server *grpc.Server, logger zerolog.Logger, stockService service.StockService) {
+	iims_pb.RegisterStockServiceServer(server, &stockServer{Logger: logger, StockService: stockService})
+}
+
+func (s *stockServer) GetStock(ctx context.Context, req *iims_pb.GetStockRequest) (*iims_pb.StockMessage, error) {
+	s.Logger.Debug().Msg("Get Stock")
+
+	result, err := s.StockService.GetStock(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("StockService GetStock error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *stockServer) AdjustStock(ctx context.Context, req *iims_pb.AdjustStockRequest) (*iims_pb.StockMessage, error) {
+	s.Logger.Debug().Msg("Adjust Stock")
+
+	result, err := s.StockService.AdjustStock(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("StockService AdjustStock error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *stockServer) Reserve(ctx context.Context, req *iims_pb.StockReservationRequest) (*iims_pb.StockMessage, error) {
+	s.Logger.Debug().Msg("Reserve Stock")
+
+	result, err := s.StockService.Reserve(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("StockService Reserve error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *stockServer) Release(ctx context.Context, req *iims_pb.StockReservationRequest) (*iims_pb.StockMessage, error) {
+	s.Logger.Debug().Msg("Release Stock")
+
+	result, err := s.StockService.Release(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("StockService Release error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *stockServer) ListMovements(ctx context.Context, req *iims_pb.ListMovementsRequest) (*iims_pb.ListMovementsResponse, error) {
+	s.Logger.Debug().Msg("List Stock Movements")
+
+	result, err := s.StockService.ListMovements(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("StockService ListMovements error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *stockServer) RebuildBalances(ctx context.Context, req *iims_pb.RebuildBalancesRequest) (*iims_pb.RebuildBalancesResponse, error) {
+	s.Logger.Debug().Msg("Rebuild Stock Balances")
+
+	result, err := s.StockService.RebuildBalances(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("StockService RebuildBalances error")
+		return nil, err
+	}
+
+	return result, nil
+}
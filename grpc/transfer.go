@@ -0,0 +1,55 @@
+package grpc
+
+import (
+	"context"
+	iims_pb "github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+)
+
+type transferServer struct {
+	iims_pb.UnimplementedTransferServiceServer
+	Logger          zerolog.Logger
+	TransferService service.TransferService
+}
+
+func RegisterTransferServer(server *grpc.Server, logger zerolog.Logger, transferService service.TransferService) {
+	iims_pb.RegisterTransferServiceServer(server, &transferServer{Logger: logger, TransferService: transferService})
+}
+
+func (s *transferServer) CreateTransfer(ctx context.Context, req *iims_pb.CreateTransferRequest) (*iims_pb.TransferMessage, error) {
+	s.Logger.Debug().Msg("Create Transfer")
+
+	result, err := s.TransferService.CreateTransfer(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("TransferService CreateTransfer error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *transferServer) ShipTransfer(ctx context.Context, req *iims_pb.TransferOperationMessage) (*iims_pb.TransferMessage, error) {
+	s.Logger.Debug().Msg("Ship Transfer")
+
+	result, err := s.TransferService.ShipTransfer(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("TransferService ShipTransfer error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *transferServer) ReceiveTransfer(ctx context.Context, req *iims_pb.TransferOperationMessage) (*iims_pb.TransferMessage, error) {
+	s.Logger.Debug().Msg("Receive Transfer")
+
+	result, err := s.TransferService.ReceiveTransfer(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("TransferService ReceiveTransfer error")
+		return nil, err
+	}
+
+	return result, nil
+}
@@ -0,0 +1,177 @@
+package grpc
+
+import (
+	"encoding/hex"
+	"fmt"
+	"github.com/igntnk/stocky_iims/models"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"strings"
+	"unicode/utf8"
+)
+
+// rule checks one field value and describes what is wrong with it, or returns "". Set tells
+// whether the value is given: a non-blank string, a non-zero number, a message or a non-empty
+// list. A rule marked each is checked on every item of a list instead of the list itself.
+type rule struct {
+	each  bool
+	check func(value protoreflect.Value, set bool) string
+}
+
+// messageRules holds the rules of the fields of a message by field name.
+type messageRules map[protoreflect.Name][]rule
+
+var required = rule{check: func(_ protoreflect.Value, set bool) string {
+	if !set {
+		return "is required"
+	}
+	return ""
+}}
+
+var objectId = rule{check: func(value protoreflect.Value, set bool) string {
+	if !set {
+		return ""
+	}
+	if _, err := hex.DecodeString(value.String()); err != nil || len(value.String()) != 24 {
+		return "must be a 24 character hex id"
+	}
+	return ""
+}}
+
+func maxLength(n int) rule {
+	return rule{check: func(value protoreflect.Value, set bool) string {
+		if set && utf8.RuneCountInString(value.String()) > n {
+			return fmt.Sprintf("must be at most %d characters long", n)
+		}
+		return ""
+	}}
+}
+
+func length(n int) rule {
+	return rule{check: func(value protoreflect.Value, set bool) string {
+		if set && utf8.RuneCountInString(value.String()) != n {
+			return fmt.Sprintf("must be %d characters long", n)
+		}
+		return ""
+	}}
+}
+
+func between(min, max int64) rule {
+	return rule{check: func(value protoreflect.Value, set bool) string {
+		if set && (value.Int() < min || value.Int() > max) {
+			return fmt.Sprintf("must be between %d and %d", min, max)
+		}
+		return ""
+	}}
+}
+
+func atLeast(min int64) rule {
+	return rule{check: func(value protoreflect.Value, set bool) string {
+		if set && value.Int() < min {
+			return fmt.Sprintf("must be at least %d", min)
+		}
+		return ""
+	}}
+}
+
+func each(r rule) rule {
+	return rule{each: true, check: r.check}
+}
+
+// valueSet tells whether a single value is given.
+func valueSet(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return strings.TrimSpace(value.String()) != ""
+	case protoreflect.BoolKind:
+		return value.Bool()
+	case protoreflect.EnumKind:
+		return value.Enum() != 0
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return true
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return value.Uint() != 0
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return value.Float() != 0
+	case protoreflect.BytesKind:
+		return len(value.Bytes()) > 0
+	}
+
+	return value.Int() != 0
+}
+
+// validate checks a request against requestRules. Enum fields must hold a declared value and
+// nested messages are checked against their own rules.
+func validate(message proto.Message) error {
+	violations := validateMessage(message.ProtoReflect(), "")
+	if len(violations) > 0 {
+		return &models.ValidationError{Violations: violations}
+	}
+
+	return nil
+}
+
+func validateMessage(message protoreflect.Message, path string) []models.FieldViolation {
+	violations := []models.FieldViolation{}
+	rules := requestRules[message.Descriptor().FullName()]
+
+	fields := message.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		name := path + string(field.Name())
+		value := message.Get(field)
+
+		var set bool
+		switch {
+		case field.IsList():
+			set = value.List().Len() > 0
+		case field.IsMap():
+			set = value.Map().Len() > 0
+		case field.Kind() == protoreflect.MessageKind:
+			set = message.Has(field)
+		default:
+			set = valueSet(field, value)
+		}
+
+		for _, r := range rules[field.Name()] {
+			if r.each && field.IsList() {
+				list := value.List()
+				for j := 0; j < list.Len(); j++ {
+					if description := r.check(list.Get(j), valueSet(field, list.Get(j))); description != "" {
+						violations = append(violations, models.FieldViolation{Field: fmt.Sprintf("%s[%d]", name, j), Description: description})
+					}
+				}
+				continue
+			}
+			if description := r.check(value, set); description != "" {
+				violations = append(violations, models.FieldViolation{Field: name, Description: description})
+			}
+		}
+
+		switch {
+		case field.IsMap():
+		case field.IsList():
+			list := value.List()
+			for j := 0; j < list.Len(); j++ {
+				violations = append(violations, validateValue(field, list.Get(j), fmt.Sprintf("%s[%d]", name, j))...)
+			}
+		case set:
+			violations = append(violations, validateValue(field, value, name)...)
+		}
+	}
+
+	return violations
+}
+
+func validateValue(field protoreflect.FieldDescriptor, value protoreflect.Value, name string) []models.FieldViolation {
+	switch field.Kind() {
+	case protoreflect.MessageKind:
+		return validateMessage(value.Message(), name+".")
+	case protoreflect.EnumKind:
+		if field.Enum().Values().ByNumber(value.Enum()) == nil {
+			return []models.FieldViolation{{Field: name, Description: "has an unknown value"}}
+		}
+	}
+
+	return nil
+}
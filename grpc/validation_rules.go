@@ -0,0 +1,128 @@
+package grpc
+
+import (
+	"github.com/igntnk/stocky_iims/models"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	maxNameLength        = 200
+	maxDescriptionLength = 2000
+	maxCodeLength        = 64
+)
+
+var (
+	pageLimit  = between(0, models.MaxPageLimit)
+	pageOffset = atLeast(0)
+	name       = maxLength(maxNameLength)
+	desc       = maxLength(maxDescriptionLength)
+	code       = maxLength(maxCodeLength)
+)
+
+// requestRules declares the field rules of the requests. Messages nested in a request are
+// checked against their own entry, Money for every price for instance.
+var requestRules = map[protoreflect.FullName]messageRules{
+	"iims.Money": {
+		"Amount":   {atLeast(0)},
+		"Currency": {required, length(3)},
+	},
+
+	"iims.InsertProductRequest": {
+		"Name":        {required, name},
+		"Description": {desc},
+		"ProductCode": {code},
+		"Barcodes":    {each(required), each(code)},
+		"CategoryId":  {objectId},
+		"Price":       {required},
+	},
+	"iims.GetProductsRequest": {
+		"Limit":        {pageLimit},
+		"Offset":       {pageOffset},
+		"NameContains": {name},
+		"CategoryId":   {objectId},
+	},
+	"iims.GetByIdProductRequest":        {"id": {required, objectId}},
+	"iims.GetByProductCodeRequest":      {"code": {required, code}},
+	"iims.GetByBarcodeRequest":          {"code": {required, code}},
+	"iims.DeleteProductRequest":         {"Id": {required, objectId}},
+	"iims.RestoreProductRequest":        {"Id": {required, objectId}},
+	"iims.PurgeProductRequest":          {"Id": {required, objectId}},
+	"iims.BlockProductOperationMessage": {"Id": {required, objectId}, "Actor": {name}, "Reason": {desc}},
+	"iims.GetAvailabilityRequest":       {"ProductId": {required, objectId}},
+	"iims.SearchProductsRequest": {
+		"Query":  {required, name},
+		"Limit":  {pageLimit},
+		"Offset": {pageOffset},
+	},
+	"iims.UpdateProductRequest": {
+		"Id":          {required, objectId},
+		"Name":        {required, name},
+		"Description": {desc},
+		"ProductCode": {code},
+		"Barcodes":    {each(required), each(code)},
+		"CategoryId":  {objectId},
+	},
+	"iims.InsertVariantRequest": {
+		"ProductId":   {required, objectId},
+		"ProductCode": {code},
+		"Barcode":     {code},
+	},
+	"iims.UpdateVariantRequest": {
+		"Id":          {required, objectId},
+		"ProductCode": {code},
+		"Barcode":     {code},
+	},
+	"iims.DeleteVariantRequest": {"Id": {required, objectId}},
+	"iims.SchedulePriceChangeRequest": {
+		"ProductId":     {required, objectId},
+		"Price":         {required},
+		"EffectiveFrom": {required},
+	},
+	"iims.GetPriceAtRequest": {
+		"ProductId": {required, objectId},
+		"At":        {required},
+	},
+
+	"iims.InsertSaleRequest": {
+		"Name":             {required, name},
+		"Description":      {desc},
+		"SaleSize":         {between(0, 100)},
+		"Product":          {objectId},
+		"BuyQuantity":      {atLeast(0)},
+		"FreeQuantity":     {atLeast(0)},
+		"BundleProducts":   {each(objectId)},
+		"Products":         {each(objectId)},
+		"Categories":       {each(objectId)},
+		"ExcludedProducts": {each(objectId)},
+		"Variants":         {each(objectId)},
+	},
+	"iims.GetSalesRequest": {
+		"Limit":     {pageLimit},
+		"Offset":    {pageOffset},
+		"ProductId": {objectId},
+	},
+	"iims.ListSalesForProductRequest": {
+		"ProductId": {required, objectId},
+		"Limit":     {pageLimit},
+		"Offset":    {pageOffset},
+	},
+	"iims.DeleteSaleRequest":  {"Id": {required, objectId}},
+	"iims.RestoreSaleRequest": {"Id": {required, objectId}},
+	"iims.PurgeSaleRequest":   {"Id": {required, objectId}},
+	"iims.UpdateSaleRequest": {
+		"Id":             {required, objectId},
+		"Name":           {required, name},
+		"Description":    {desc},
+		"SaleSize":       {between(0, 100)},
+		"BuyQuantity":    {atLeast(0)},
+		"FreeQuantity":   {atLeast(0)},
+		"BundleProducts": {each(objectId)},
+	},
+	"iims.BlockSaleOperationMessage": {"Id": {required, objectId}, "Actor": {name}, "Reason": {desc}},
+	"iims.CalculatePriceRequest":     {"ProductId": {objectId}, "VariantId": {objectId}},
+	"iims.PriceLineRequest": {
+		"ProductId": {required, objectId},
+		"Quantity":  {required, atLeast(1)},
+		"VariantId": {objectId},
+	},
+}
@@ -0,0 +1,117 @@
+package grpc
+
+import (
+	"context"
+	iims_pb "github.com/igntnk/stocky_iims/proto/pb"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"testing"
+)
+
+// TestRequestRulesNames makes sure every rule names a message and a field that exist, since
+// rules for unknown names are silently skipped.
+func TestRequestRulesNames(t *testing.T) {
+	for messageName, rules := range requestRules {
+		descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(messageName)
+		if err != nil {
+			t.Errorf("%s: %v", messageName, err)
+			continue
+		}
+		message, ok := descriptor.(protoreflect.MessageDescriptor)
+		if !ok {
+			t.Errorf("%s is not a message", messageName)
+			continue
+		}
+
+		for fieldName, fieldRules := range rules {
+			field := message.Fields().ByName(fieldName)
+			if field == nil {
+				t.Errorf("%s has no field %s", messageName, fieldName)
+				continue
+			}
+			for _, r := range fieldRules {
+				if r.each && !field.IsList() {
+					t.Errorf("%s.%s is not a list but has an each rule", messageName, fieldName)
+				}
+			}
+		}
+	}
+}
+
+func fieldViolations(t *testing.T, err error) map[string]string {
+	t.Helper()
+
+	st, ok := status.FromError(err)
+	if !ok {
+		t.Fatalf("error %v is not a status", err)
+	}
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+
+	violations := map[string]string{}
+	for _, detail := range st.Details() {
+		if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+			for _, violation := range badRequest.GetFieldViolations() {
+				violations[violation.GetField()] = violation.GetDescription()
+			}
+		}
+	}
+	if len(violations) == 0 {
+		t.Fatal("BadRequest field violations are missing")
+	}
+
+	return violations
+}
+
+func TestValidationInterceptor(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    any
+		fields []string
+	}{
+		{"missing id", &iims_pb.DeleteProductRequest{}, []string{"Id"}},
+		{"bad id", &iims_pb.DeleteSaleRequest{Id: "42"}, []string{"Id"}},
+		{"long reason", &iims_pb.BlockProductOperationMessage{Id: "0123456789abcdef01234567", Reason: string(make([]rune, maxDescriptionLength+1))}, []string{"Reason"}},
+		{"nested money", &iims_pb.InsertProductRequest{Name: "Tea", Price: &iims_pb.Money{Amount: -1, Currency: "RU"}}, []string{"Price.Amount", "Price.Currency"}},
+		{"bad list item", &iims_pb.UpdateSaleRequest{Id: "0123456789abcdef01234567", Name: "Sale", BundleProducts: []string{"0123456789abcdef01234567", "x"}}, []string{"BundleProducts[1]"}},
+		{"unknown enum", &iims_pb.InsertSaleRequest{Name: "Sale", DiscountType: iims_pb.DiscountType(99)}, []string{"DiscountType"}},
+	}
+
+	interceptor := ValidationInterceptor()
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			called := false
+			handler := func(context.Context, any) (any, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := interceptor(context.Background(), test.req, &grpc.UnaryServerInfo{}, handler)
+			if called {
+				t.Fatal("handler was called for an invalid request")
+			}
+
+			violations := fieldViolations(t, err)
+			for _, field := range test.fields {
+				if _, ok := violations[field]; !ok {
+					t.Errorf("no violation for %s in %v", field, violations)
+				}
+			}
+		})
+	}
+}
+
+func TestValidationInterceptorPassesValidRequest(t *testing.T) {
+	handler := func(context.Context, any) (any, error) { return "ok", nil }
+
+	req := &iims_pb.BlockSaleOperationMessage{Id: "0123456789abcdef01234567", Actor: "admin", Reason: "recall"}
+	resp, err := ValidationInterceptor()(context.Background(), req, &grpc.UnaryServerInfo{}, handler)
+	if err != nil || resp != "ok" {
+		t.Fatalf("got (%v, %v), want (ok, nil)", resp, err)
+	}
+}
@@ -0,0 +1,103 @@
+package grpc
+
+import (
+	"context"
+	iims_pb "github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/service"
+	"github.com/rs/zerolog"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type warehouseServer struct {
+	iims_pb.UnimplementedWarehouseServiceServer
+	Logger           zerolog.Logger
+	WarehouseService service.WarehouseService
+}
+
+func RegisterWarehouseServer(server *grpc.Server, logger zerolog.Logger, warehouseService service.WarehouseService) {
+	iims_pb.RegisterWarehouseServiceServer(server, &warehouseServer{Logger: logger, WarehouseService: warehouseService})
+}
+
+func (s *warehouseServer) InsertOne(ctx context.Context, req *iims_pb.InsertWarehouseRequest) (*iims_pb.InsertWarehouseResponse, error) {
+	s.Logger.Debug().Msg("Insert Warehouse")
+
+	result, err := s.WarehouseService.InsertOne(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("WarehouseService InsertOne error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *warehouseServer) Get(ctx context.Context, req *iims_pb.GetWarehousesRequest) (*iims_pb.GetWarehousesResponse, error) {
+	s.Logger.Debug().Msg("Get Warehouse")
+
+	result, err := s.WarehouseService.Get(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("WarehouseService Get error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *warehouseServer) GetById(ctx context.Context, req *iims_pb.GetByIdWarehouseRequest) (*iims_pb.GetWarehouseMessage, error) {
+	s.Logger.Debug().Msg("Get Warehouse")
+
+	result, err := s.WarehouseService.GetById(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("WarehouseService GetById error")
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (s *warehouseServer) Delete(ctx context.Context, req *iims_pb.DeleteWarehouseRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Delete Warehouse")
+
+	err := s.WarehouseService.Delete(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("WarehouseService Delete error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *warehouseServer) Update(ctx context.Context, req *iims_pb.UpdateWarehouseRequest) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Update Warehouse")
+
+	err := s.WarehouseService.Update(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("WarehouseService Update error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *warehouseServer) BlockWarehouse(ctx context.Context, req *iims_pb.BlockWarehouseOperationMessage) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Block Warehouse")
+
+	err := s.WarehouseService.BlockWarehouse(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("WarehouseService BlockWarehouse error")
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *warehouseServer) UnblockWarehouse(ctx context.Context, req *iims_pb.BlockWarehouseOperationMessage) (*emptypb.Empty, error) {
+	s.Logger.Debug().Msg("Unblock Warehouse")
+
+	err := s.WarehouseService.UnblockWarehouse(ctx, req)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("WarehouseService UnblockWarehouse error")
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -28,15 +28,17 @@ func main() {
 
 	logger.Info().Msg("Connection is established. Mongo use topology: " + topology.String())
 
-	isReplicaSet := false
-	if topology.Kind()&description.ReplicaSet == description.ReplicaSet {
-		isReplicaSet = true
+	// Stock, transfer and price writes span several collections and rely on multi-document
+	// transactions, which a standalone server does not have.
+	kind := topology.Kind()
+	transactions := kind&description.ReplicaSet == description.ReplicaSet || kind == description.Sharded || kind == description.LoadBalanced
+	if !transactions {
+		logger.Fatal().Msg("Mongo must run as a replica set or a sharded cluster, transactions are required")
 	}
 
 	ctx, cancel := context.WithCancel(ctx)
-	defer cancel()
 
-	err = setup.Init(ctx, db, isReplicaSet, logger, cfg)
+	err = setup.Init(ctx, db, logger, cfg)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("")
 	}
@@ -50,5 +52,9 @@ func main() {
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 	<-stop
+
+	// The background workers stop first, so that none of them is left writing while the server
+	// shuts down.
+	cancel()
 	grpcServ.Stop()
 }
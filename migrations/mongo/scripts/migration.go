@@ -5,6 +5,8 @@ import (
 	"go.mongodb.org/mongo-driver/mongo"
 )
 
+// Migration is a migration step written in Go. Up runs on every start after its version has
+// been applied, so it must leave data it has already migrated as it is.
 type Migration interface {
 	Up(ctx context.Context, db *mongo.Database) error
 	Down(ctx context.Context, db *mongo.Database) error
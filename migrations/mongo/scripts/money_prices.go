@@ -0,0 +1,75 @@
+package scripts
+
+import (
+	"context"
+	"fmt"
+	"github.com/igntnk/stocky_iims/models"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"math"
+)
+
+const DefaultPriceCurrency = "RUB"
+
+var moneyPriceCollections = []string{"products", "product_variants"}
+
+// MoneyPrices converts prices stored as doubles into money documents with an amount in minor
+// units. Stored doubles carry no currency, so the configured one is assigned to all of them.
+type MoneyPrices struct {
+	Currency string
+}
+
+func (m MoneyPrices) currency() (string, float64, error) {
+	currency := m.Currency
+	if currency == "" {
+		currency = DefaultPriceCurrency
+	}
+
+	digits, ok := models.MinorUnits(currency)
+	if !ok {
+		return "", 0, fmt.Errorf("unsupported price currency %q", currency)
+	}
+
+	return currency, math.Pow10(digits), nil
+}
+
+func (m MoneyPrices) Up(ctx context.Context, db *mongo.Database) error {
+	currency, factor, err := m.currency()
+	if err != nil {
+		return err
+	}
+
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{"price": bson.M{
+		"amount":   bson.M{"$toLong": bson.M{"$round": bson.A{bson.M{"$multiply": bson.A{"$price", factor}}, 0}}},
+		"currency": currency,
+	}}}}}
+
+	for _, collection := range moneyPriceCollections {
+		_, err = db.Collection(collection).UpdateMany(ctx, bson.M{"price": bson.M{"$type": "number"}}, update)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m MoneyPrices) Down(ctx context.Context, db *mongo.Database) error {
+	currency, factor, err := m.currency()
+	if err != nil {
+		return err
+	}
+
+	update := mongo.Pipeline{{{Key: "$set", Value: bson.M{
+		"price": bson.M{"$divide": bson.A{"$price.amount", factor}},
+	}}}}
+
+	for _, collection := range moneyPriceCollections {
+		_, err = db.Collection(collection).UpdateMany(ctx, bson.M{"price.currency": currency}, update)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package scripts
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+// PriceHistory opens a history entry with the current price of every product that has none.
+// The entry starts at the product creation date, or at the Unix epoch when there is none. The
+// date is still an RFC 3339 string when the step first runs on a fresh database, before
+// migration 26 stores it as a date. Only the products without an entry are read, so that once every product has
+// one a start does not go through the whole catalogue.
+type PriceHistory struct{}
+
+func (m PriceHistory) Up(ctx context.Context, db *mongo.Database) error {
+	products, err := db.Collection("products").Aggregate(ctx, mongo.Pipeline{
+		{{Key: "$project", Value: bson.M{"price": 1, "creation_date": 1, "hex_id": bson.M{"$toString": "$_id"}}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         "price_history",
+			"localField":   "hex_id",
+			"foreignField": "product_id",
+			"pipeline": bson.A{
+				bson.M{"$match": bson.M{"status": "applied"}},
+				bson.M{"$limit": 1},
+				bson.M{"$project": bson.M{"_id": 1}},
+			},
+			"as": "history",
+		}}},
+		{{Key: "$match", Value: bson.M{"history": bson.M{"$size": 0}}}},
+	})
+	if err != nil {
+		return err
+	}
+	defer products.Close(ctx)
+
+	history := db.Collection("price_history")
+	for products.Next(ctx) {
+		product := struct {
+			Id           primitive.ObjectID `bson:"_id"`
+			Price        bson.RawValue      `bson:"price"`
+			CreationDate bson.RawValue      `bson:"creation_date"`
+		}{}
+		if err = products.Decode(&product); err != nil {
+			return err
+		}
+
+		effectiveFrom := creationTime(product.CreationDate)
+
+		_, err = history.UpdateOne(ctx,
+			bson.M{"product_id": product.Id.Hex(), "status": "applied"},
+			bson.M{"$setOnInsert": bson.M{
+				"price":          product.Price,
+				"effective_from": effectiveFrom,
+				"created_at":     time.Now(),
+			}},
+			options.Update().SetUpsert(true))
+		if err != nil {
+			return err
+		}
+	}
+
+	return products.Err()
+}
+
+// creationTime reads a stored creation date, either a date or an RFC 3339 string.
+func creationTime(value bson.RawValue) time.Time {
+	if date, ok := value.TimeOK(); ok {
+		return date
+	}
+	if s, ok := value.StringValueOK(); ok {
+		if date, err := time.Parse(time.RFC3339, s); err == nil {
+			return date
+		}
+	}
+
+	return time.Unix(0, 0)
+}
+
+// Down has nothing to undo: the down migration drops the whole price_history collection.
+func (m PriceHistory) Down(ctx context.Context, db *mongo.Database) error {
+	return nil
+}
@@ -0,0 +1,40 @@
+package scripts
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"testing"
+	"time"
+)
+
+func TestCreationTime(t *testing.T) {
+	date := time.Date(2025, 1, 15, 9, 30, 0, 0, time.UTC)
+	value := func(v any) bson.RawValue {
+		kind, data, err := bson.MarshalValue(v)
+		if err != nil {
+			t.Fatalf("MarshalValue(%v): %v", v, err)
+		}
+		return bson.RawValue{Type: kind, Value: data}
+	}
+
+	tests := []struct {
+		name  string
+		value bson.RawValue
+		want  time.Time
+	}{
+		{"date", value(date), date},
+		{"rfc 3339 string", value("2025-01-15T09:30:00Z"), date},
+		{"offset string", value("2025-01-15T12:30:00+03:00"), date},
+		{"empty string", value(""), time.Unix(0, 0)},
+		{"missing", bson.RawValue{}, time.Unix(0, 0)},
+		{"null", bson.RawValue{Type: bsontype.Null}, time.Unix(0, 0)},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := creationTime(test.value); !got.Equal(test.want) {
+				t.Errorf("creationTime() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package scripts
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	ProductSearchIndex            = "product_search"
+	DefaultTextSearchLanguage     = "russian"
+	productSearchLanguageOverride = "search_language"
+)
+
+// ProductSearch creates the text index behind product search. The index language is taken from
+// configuration because it decides how words are stemmed; a product may still override it with
+// its own search_language field. An index built with other options, a language configured
+// before for one, is dropped and built again.
+type ProductSearch struct {
+	Language string
+}
+
+var productSearchWeights = bson.D{
+	{Key: "name", Value: 10},
+	{Key: "product_code", Value: 5},
+	{Key: "description", Value: 1},
+}
+
+// productSearchSpec is the part of a listed index that decides whether it can stay.
+type productSearchSpec struct {
+	Name             string           `bson:"name"`
+	DefaultLanguage  string           `bson:"default_language"`
+	LanguageOverride string           `bson:"language_override"`
+	Weights          map[string]int32 `bson:"weights"`
+}
+
+// matches reports whether an existing index was built with the options the step would use.
+func (s productSearchSpec) matches(language string) bool {
+	if s.DefaultLanguage != language || s.LanguageOverride != productSearchLanguageOverride {
+		return false
+	}
+	if len(s.Weights) != len(productSearchWeights) {
+		return false
+	}
+	for _, weight := range productSearchWeights {
+		if s.Weights[weight.Key] != int32(weight.Value.(int)) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (m ProductSearch) Up(ctx context.Context, db *mongo.Database) error {
+	language := m.Language
+	if language == "" {
+		language = DefaultTextSearchLanguage
+	}
+
+	indexes := db.Collection("products").Indexes()
+
+	specs, err := indexes.List(ctx)
+	if err != nil {
+		return err
+	}
+	defer specs.Close(ctx)
+
+	for specs.Next(ctx) {
+		spec := productSearchSpec{}
+		if err = specs.Decode(&spec); err != nil {
+			return err
+		}
+		if spec.Name != ProductSearchIndex {
+			continue
+		}
+		if spec.matches(language) {
+			return nil
+		}
+		if _, err = indexes.DropOne(ctx, ProductSearchIndex); err != nil {
+			return err
+		}
+		break
+	}
+	if err = specs.Err(); err != nil {
+		return err
+	}
+
+	_, err = indexes.CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "name", Value: "text"},
+			{Key: "description", Value: "text"},
+			{Key: "product_code", Value: "text"},
+		},
+		Options: options.Index().
+			SetName(ProductSearchIndex).
+			SetDefaultLanguage(language).
+			SetLanguageOverride(productSearchLanguageOverride).
+			SetWeights(productSearchWeights),
+	})
+	return err
+}
+
+func (m ProductSearch) Down(ctx context.Context, db *mongo.Database) error {
+	_, err := db.Collection("products").Indexes().DropOne(ctx, ProductSearchIndex)
+	return err
+}
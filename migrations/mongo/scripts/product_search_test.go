@@ -0,0 +1,39 @@
+package scripts
+
+import "testing"
+
+func TestProductSearchSpecMatches(t *testing.T) {
+	weights := map[string]int32{"name": 10, "product_code": 5, "description": 1}
+
+	tests := []struct {
+		name string
+		spec productSearchSpec
+		want bool
+	}{
+		{
+			name: "same options",
+			spec: productSearchSpec{DefaultLanguage: "russian", LanguageOverride: productSearchLanguageOverride, Weights: weights},
+			want: true,
+		},
+		{
+			name: "other language",
+			spec: productSearchSpec{DefaultLanguage: "english", LanguageOverride: productSearchLanguageOverride, Weights: weights},
+		},
+		{
+			name: "default override",
+			spec: productSearchSpec{DefaultLanguage: "russian", LanguageOverride: "language", Weights: weights},
+		},
+		{
+			name: "other weights",
+			spec: productSearchSpec{DefaultLanguage: "russian", LanguageOverride: productSearchLanguageOverride, Weights: map[string]int32{"name": 1}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.spec.matches("russian"); got != test.want {
+				t.Errorf("matches() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
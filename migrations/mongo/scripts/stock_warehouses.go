@@ -0,0 +1,59 @@
+package scripts
+
+import (
+	"context"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const UnassignedWarehouseName = "Unassigned stock"
+
+var stockWarehouseCollections = []string{"stock", "stock_movements"}
+
+// StockWarehouses assigns the balances and movements recorded before warehouses existed to a
+// warehouse of their own, so that they can be read and moved like any other stock. The warehouse
+// is created only when such stock is found.
+type StockWarehouses struct{}
+
+func (m StockWarehouses) Up(ctx context.Context, db *mongo.Database) error {
+	unassigned := bson.M{"warehouse_id": bson.M{"$in": bson.A{nil, ""}}}
+
+	found := false
+	for _, name := range stockWarehouseCollections {
+		count, err := db.Collection(name).CountDocuments(ctx, unassigned, options.Count().SetLimit(1))
+		if err != nil {
+			return err
+		}
+		found = found || count > 0
+	}
+	if !found {
+		return nil
+	}
+
+	warehouse := struct {
+		Id primitive.ObjectID `bson:"_id"`
+	}{}
+	err := db.Collection("warehouses").FindOneAndUpdate(ctx,
+		bson.M{"name": UnassignedWarehouseName},
+		bson.M{"$setOnInsert": bson.M{"address": "", "blocked": false}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)).Decode(&warehouse)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range stockWarehouseCollections {
+		_, err = db.Collection(name).UpdateMany(ctx, unassigned, bson.M{"$set": bson.M{"warehouse_id": warehouse.Id.Hex()}})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Down leaves the stock where it is: the balances keep the warehouse they were assigned to.
+func (m StockWarehouses) Down(ctx context.Context, db *mongo.Database) error {
+	return nil
+}
@@ -0,0 +1,21 @@
+package models
+
+type AttributeType string
+
+const (
+	AttributeTypeString AttributeType = "string"
+	AttributeTypeNumber AttributeType = "number"
+	AttributeTypeBool   AttributeType = "bool"
+	AttributeTypeEnum   AttributeType = "enum"
+)
+
+// AttributeDefinition describes one custom product attribute of a category. It applies to the
+// products of the category and of all its subcategories.
+type AttributeDefinition struct {
+	Id            string        `json:"id" bson:"_id,omitempty"`
+	CategoryId    string        `json:"category_id" bson:"category_id"`
+	Name          string        `json:"name" bson:"name"`
+	Type          AttributeType `json:"type" bson:"type"`
+	Required      bool          `json:"required" bson:"required"`
+	AllowedValues []string      `json:"allowed_values,omitempty" bson:"allowed_values,omitempty"`
+}
@@ -0,0 +1,15 @@
+package models
+
+// Category is a node of the product category tree. Ancestors holds the ids of all parent
+// categories from the root down, so a whole subtree can be selected with one indexed query.
+type Category struct {
+	Id          string   `json:"id" bson:"_id,omitempty"`
+	Name        string   `json:"name" bson:"name"`
+	Description string   `json:"description" bson:"description"`
+	ParentId    string   `json:"parent_id,omitempty" bson:"parent_id,omitempty"`
+	Ancestors   []string `json:"ancestors" bson:"ancestors"`
+}
+
+func (c Category) Path() []string {
+	return append(append([]string{}, c.Ancestors...), c.Id)
+}
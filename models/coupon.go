@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Coupon is a redeemable code for a sale. A zero MaxRedemptions or MaxPerCustomer means no
+// limit. Redemptions counts the redemptions so far.
+type Coupon struct {
+	Id             string     `json:"id" bson:"_id,omitempty"`
+	Code           string     `json:"code" bson:"code"`
+	SaleId         string     `json:"sale_id" bson:"sale_id"`
+	MaxRedemptions int64      `json:"max_redemptions" bson:"max_redemptions"`
+	MaxPerCustomer int64      `json:"max_per_customer" bson:"max_per_customer"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty" bson:"expires_at,omitempty"`
+	Redemptions    int64      `json:"redemptions" bson:"redemptions"`
+	CreatedAt      time.Time  `json:"created_at" bson:"created_at"`
+}
+
+// Expired tells whether the coupon can no longer be redeemed at the time t.
+func (c Coupon) Expired(t time.Time) bool {
+	return c.ExpiresAt != nil && !t.Before(*c.ExpiresAt)
+}
+
+// CouponRedemption counts the redemptions of a coupon by one customer.
+type CouponRedemption struct {
+	CouponId       string    `json:"coupon_id" bson:"coupon_id"`
+	CustomerId     string    `json:"customer_id" bson:"customer_id"`
+	Redemptions    int64     `json:"redemptions" bson:"redemptions"`
+	LastRedeemedAt time.Time `json:"last_redeemed_at" bson:"last_redeemed_at"`
+}
+
+// CouponRejection tells why a coupon cannot be redeemed.
+type CouponRejection string
+
+const (
+	CouponRejectionExpired       CouponRejection = "expired"
+	CouponRejectionExhausted     CouponRejection = "exhausted"
+	CouponRejectionCustomerLimit CouponRejection = "customer_limit"
+	CouponRejectionSaleInactive  CouponRejection = "sale_inactive"
+)
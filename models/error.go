@@ -0,0 +1,59 @@
+package models
+
+import (
+	"errors"
+	"strings"
+)
+
+// Error kinds. Every error the repositories and services return on purpose is of one of these
+// kinds, which the gRPC layer turns into a status code.
+var (
+	ErrNotFound           = errors.New("not found")
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrConflict           = errors.New("conflict")
+	ErrBlocked            = errors.New("blocked")
+	ErrPreconditionFailed = errors.New("precondition failed")
+)
+
+// Error is a domain error of a kind. errors.Is matches both the error itself and its kind.
+type Error struct {
+	Kind    error
+	Message string
+}
+
+// NewError declares a domain error of a kind.
+func NewError(kind error, message string) error {
+	return &Error{Kind: kind, Message: message}
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+// FieldViolation names a request field and what is wrong with it.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError is an invalid argument error listing every field that failed validation.
+type ValidationError struct {
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Violations))
+	for i, violation := range e.Violations {
+		messages[i] = violation.Field + " " + violation.Description
+	}
+
+	return "invalid request: " + strings.Join(messages, "; ")
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidArgument
+}
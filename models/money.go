@@ -0,0 +1,26 @@
+package models
+
+// Money is an exact amount in the minor units of an ISO 4217 currency, for example cents.
+type Money struct {
+	Amount   int64  `json:"amount" bson:"amount"`
+	Currency string `json:"currency" bson:"currency"`
+}
+
+// currencyMinorUnits lists the supported currencies with the number of digits after the decimal
+// point of their major unit.
+var currencyMinorUnits = map[string]int{
+	"BYN": 2,
+	"CNY": 2,
+	"EUR": 2,
+	"GBP": 2,
+	"JPY": 0,
+	"KZT": 2,
+	"RUB": 2,
+	"USD": 2,
+}
+
+// MinorUnits returns the number of minor unit digits of a currency and whether it is supported.
+func MinorUnits(currency string) (int, bool) {
+	digits, ok := currencyMinorUnits[currency]
+	return digits, ok
+}
@@ -0,0 +1,40 @@
+package models
+
+import "time"
+
+type MovementReason string
+
+const (
+	MovementReasonReceipt    MovementReason = "receipt"
+	MovementReasonSale       MovementReason = "sale"
+	MovementReasonAdjustment MovementReason = "adjustment"
+	MovementReasonTransfer   MovementReason = "transfer"
+	MovementReasonWriteOff   MovementReason = "write_off"
+	// MovementReasonOpeningBalance is booked by the migration for quantities held before the ledger.
+	MovementReasonOpeningBalance MovementReason = "opening_balance"
+)
+
+type StockMovement struct {
+	Id         string `json:"id" bson:"_id,omitempty"`
+	StockKey   `bson:",inline"`
+	Reason     MovementReason `json:"reason" bson:"reason"`
+	Delta      int64          `json:"delta" bson:"delta"`
+	Actor      string         `json:"actor" bson:"actor"`
+	TransferId string         `json:"transfer_id,omitempty" bson:"transfer_id,omitempty"`
+	CreatedAt  time.Time      `json:"created_at" bson:"created_at"`
+}
+
+type MovementFilter struct {
+	ProductId   string
+	WarehouseId string
+	VariantId   string
+	From        *time.Time
+	To          *time.Time
+}
+
+// BalanceDrift compares the quantity stored on a stock document with the sum of its ledger entries.
+type BalanceDrift struct {
+	StockKey
+	Recorded int64
+	Rebuilt  int64
+}
@@ -0,0 +1,22 @@
+package models
+
+const (
+	// DefaultPageLimit is the page size of a listing that does not ask for one.
+	DefaultPageLimit int64 = 50
+	// MaxPageLimit caps the page size a listing may ask for.
+	MaxPageLimit int64 = 1000
+)
+
+// PageRequest selects a page of a listing. Token, when set, continues after the last item of the
+// previous page and takes precedence over Offset.
+type PageRequest struct {
+	Limit        int64
+	Offset       int64
+	Token        string
+	IncludeTotal bool
+}
+
+type PageInfo struct {
+	NextToken  string
+	TotalCount int64
+}
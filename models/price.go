@@ -0,0 +1,24 @@
+package models
+
+import "time"
+
+type PriceChangeStatus string
+
+const (
+	PriceChangeScheduled PriceChangeStatus = "scheduled"
+	PriceChangeApplied   PriceChangeStatus = "applied"
+	PriceChangeFailed    PriceChangeStatus = "failed"
+)
+
+// PriceChange is one entry of the price history of a product. An applied change is in effect
+// from EffectiveFrom until EffectiveTo, which stays empty for the current price. A scheduled
+// change waits for its EffectiveFrom time; it is marked failed when its product is gone by then.
+type PriceChange struct {
+	Id            string            `json:"id" bson:"_id,omitempty"`
+	ProductId     string            `json:"product_id" bson:"product_id"`
+	Price         Money             `json:"price" bson:"price"`
+	Status        PriceChangeStatus `json:"status" bson:"status"`
+	EffectiveFrom time.Time         `json:"effective_from" bson:"effective_from"`
+	EffectiveTo   *time.Time        `json:"effective_to,omitempty" bson:"effective_to,omitempty"`
+	CreatedAt     time.Time         `json:"created_at" bson:"created_at"`
+}
@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+type SalesChannel string
+
+const (
+	SalesChannelRetail    SalesChannel = "retail"
+	SalesChannelWholesale SalesChannel = "wholesale"
+	SalesChannelOnline    SalesChannel = "online"
+)
+
+// PriceList overrides base product prices for one sales channel. When several lists apply,
+// the one with the highest priority wins. Validity bounds are optional.
+type PriceList struct {
+	Id        string          `json:"id" bson:"_id,omitempty"`
+	Name      string          `json:"name" bson:"name"`
+	Channel   SalesChannel    `json:"channel" bson:"channel"`
+	Priority  int32           `json:"priority" bson:"priority"`
+	ValidFrom *time.Time      `json:"valid_from,omitempty" bson:"valid_from,omitempty"`
+	ValidTo   *time.Time      `json:"valid_to,omitempty" bson:"valid_to,omitempty"`
+	Items     []PriceListItem `json:"items" bson:"items"`
+}
+
+// PriceListItem holds the quantity-break tiers of one product. A tier applies from its minimum
+// quantity up to the next tier.
+type PriceListItem struct {
+	ProductId string      `json:"product_id" bson:"product_id"`
+	Tiers     []PriceTier `json:"tiers" bson:"tiers"`
+}
+
+type PriceTier struct {
+	MinQuantity int64 `json:"min_quantity" bson:"min_quantity"`
+	Price       Money `json:"price" bson:"price"`
+}
+
+// Item returns the entry of a product in the list.
+func (l PriceList) Item(productId string) (PriceListItem, bool) {
+	for _, item := range l.Items {
+		if item.ProductId == productId {
+			return item, true
+		}
+	}
+
+	return PriceListItem{}, false
+}
+
+// Tier returns the tier with the largest minimum quantity that the quantity reaches.
+func (i PriceListItem) Tier(quantity int64) (PriceTier, bool) {
+	var (
+		result PriceTier
+		found  bool
+	)
+	for _, tier := range i.Tiers {
+		if tier.MinQuantity <= quantity && (!found || tier.MinQuantity > result.MinQuantity) {
+			result, found = tier, true
+		}
+	}
+
+	return result, found
+}
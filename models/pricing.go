@@ -0,0 +1,30 @@
+package models
+
+// SaleCombinePolicy decides how several live sales on one product are combined.
+type SaleCombinePolicy string
+
+const (
+	// SaleCombineBestOf applies only the sale that gives the lowest price.
+	SaleCombineBestOf SaleCombinePolicy = "best_of"
+	// SaleCombineStack applies every sale in turn, each to the price left by the previous one.
+	SaleCombineStack SaleCombinePolicy = "stack"
+	// SaleCombineExclusive applies only the sale that started first; later sales are ignored.
+	SaleCombineExclusive SaleCombinePolicy = "exclusive"
+)
+
+// AppliedSale is a sale that took part in a price calculation and the amount it took off the line.
+type AppliedSale struct {
+	Sale     Sale
+	Discount Money
+}
+
+// PricedLine is the outcome of pricing one product, or one variant of it, in a basket. LineTotal
+// is BasePrice times Quantity less the discounts of Sales.
+type PricedLine struct {
+	ProductId string
+	VariantId string
+	Quantity  int64
+	BasePrice Money
+	Sales     []AppliedSale
+	LineTotal Money
+}
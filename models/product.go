@@ -1,9 +1,55 @@
 package models
 
+import "time"
+
+// Product attributes hold typed values: strings for string and enum attributes, float64 for
+// numbers and bool for flags. A blocked product cannot be priced or reserved; BlockedBy,
+// BlockedAt and BlockedReason are kept only while it is blocked. A deleted product keeps its
+// DeletedAt until it is purged. CreationDate is zero for products whose stored creation date
+// could not be read as a time.
 type Product struct {
-	Id           string  `json:"id" bson:"id"`
-	Name         string  `json:"name" bson:"name"`
-	Description  string  `json:"description" bson:"description"`
-	Price        float64 `json:"price" bson:"price"`
-	CreationDate string  `json:"creation_date" bson:"creation_date"`
+	Id            string         `json:"id" bson:"_id,omitempty"`
+	ProductCode   string         `json:"product_code" bson:"product_code"`
+	Barcodes      []string       `json:"barcodes,omitempty" bson:"barcodes,omitempty"`
+	CategoryId    string         `json:"category_id,omitempty" bson:"category_id,omitempty"`
+	Name          string         `json:"name" bson:"name"`
+	Description   string         `json:"description" bson:"description"`
+	Price         Money          `json:"price" bson:"price"`
+	CreationDate  time.Time      `json:"creation_date" bson:"creation_date"`
+	Attributes    map[string]any `json:"attributes,omitempty" bson:"attributes,omitempty"`
+	Blocked       bool           `json:"blocked" bson:"blocked"`
+	BlockedBy     string         `json:"blocked_by,omitempty" bson:"blocked_by,omitempty"`
+	BlockedAt     *time.Time     `json:"blocked_at,omitempty" bson:"blocked_at,omitempty"`
+	BlockedReason string         `json:"blocked_reason,omitempty" bson:"blocked_reason,omitempty"`
+	DeletedAt     *time.Time     `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+}
+
+type ScoredProduct struct {
+	Product `bson:",inline"`
+	Score   float64 `json:"score" bson:"score"`
+}
+
+const (
+	ProductSortName         = "name"
+	ProductSortPrice        = "price.amount"
+	ProductSortCreationDate = "creation_date"
+)
+
+// ProductFilter narrows and orders a product listing. Creation dates are RFC 3339 strings,
+// matching how they are stored. Price bounds share one currency. Each attribute matches any of
+// the listed values. Blocked products are left out unless Blocked asks for them or IncludeBlocked
+// is set, and deleted products unless IncludeDeleted is set.
+type ProductFilter struct {
+	CategoryIds    []string
+	Attributes     map[string][]any
+	NameContains   string
+	MinPrice       *Money
+	MaxPrice       *Money
+	Blocked        *bool
+	CreatedFrom    *time.Time
+	CreatedTo      *time.Time
+	SortField      string
+	SortDescending bool
+	IncludeBlocked bool
+	IncludeDeleted bool
 }
@@ -1,9 +1,136 @@
 package models
 
+import (
+	"slices"
+	"time"
+)
+
+type SaleStatus string
+
+const (
+	SaleStatusScheduled SaleStatus = "scheduled"
+	SaleStatusActive    SaleStatus = "active"
+	SaleStatusExpired   SaleStatus = "expired"
+)
+
+// DiscountType tells how a sale takes money off.
+type DiscountType string
+
+const (
+	// DiscountPercentage takes SaleSize per cent off every unit.
+	DiscountPercentage DiscountType = "percentage"
+	// DiscountFixedAmount takes Amount off every unit.
+	DiscountFixedAmount DiscountType = "fixed_amount"
+	// DiscountFixedPrice sells every unit for Amount.
+	DiscountFixedPrice DiscountType = "fixed_price"
+	// DiscountBuyXGetY gives FreeQuantity units away for every BuyQuantity units paid for.
+	DiscountBuyXGetY DiscountType = "buy_x_get_y"
+	// DiscountBundle sells one unit of the only target product together with one unit of every
+	// bundle product for Amount.
+	DiscountBundle DiscountType = "bundle"
+)
+
+// SaleTarget tells which products a sale applies to.
+type SaleTarget string
+
+const (
+	// SaleTargetProducts applies to the products in ProductIds, or only to the variants of them
+	// in VariantIds when those are given.
+	SaleTargetProducts SaleTarget = "products"
+	// SaleTargetCategories applies to every product in the subtrees of CategoryIds.
+	SaleTargetCategories SaleTarget = "categories"
+	// SaleTargetAll applies to every product.
+	SaleTargetAll SaleTarget = "all"
+)
+
+// Sale is live between StartsAt and EndsAt. Either bound may be left open. Status is kept in
+// step with the window by the sale scheduler. Product references are stored as ObjectIds by
+// the repository. A blocked sale never applies; BlockedBy, BlockedAt and BlockedReason are kept
+// only while it is blocked. A deleted sale keeps its DeletedAt until it is purged.
 type Sale struct {
-	Id          string `json:"id" bson:"id"`
-	Name        string `json:"name" bson:"name"`
-	Description string `json:"description" bson:"description"`
-	SaleSize    int    `json:"sale_size" bson:"sale_size"`
-	ProductId   string `json:"product_id" bson:"product_id"`
+	Id                 string       `json:"id" bson:"_id,omitempty"`
+	Name               string       `json:"name" bson:"name"`
+	Description        string       `json:"description" bson:"description"`
+	SaleSize           int          `json:"sale_size" bson:"sale_size"`
+	Target             SaleTarget   `json:"target" bson:"target"`
+	ProductIds         []string     `json:"product_ids,omitempty" bson:"-"`
+	CategoryIds        []string     `json:"category_ids,omitempty" bson:"category_ids,omitempty"`
+	ExcludedProductIds []string     `json:"excluded_product_ids,omitempty" bson:"-"`
+	VariantIds         []string     `json:"variant_ids,omitempty" bson:"-"`
+	DiscountType       DiscountType `json:"discount_type" bson:"discount_type"`
+	Amount             *Money       `json:"amount,omitempty" bson:"amount,omitempty"`
+	BuyQuantity        int64        `json:"buy_quantity,omitempty" bson:"buy_quantity,omitempty"`
+	FreeQuantity       int64        `json:"free_quantity,omitempty" bson:"free_quantity,omitempty"`
+	BundleProductIds   []string     `json:"bundle_product_ids,omitempty" bson:"-"`
+	StartsAt           *time.Time   `json:"starts_at,omitempty" bson:"starts_at,omitempty"`
+	EndsAt             *time.Time   `json:"ends_at,omitempty" bson:"ends_at,omitempty"`
+	Status             SaleStatus   `json:"status,omitempty" bson:"status,omitempty"`
+	Blocked            bool         `json:"blocked" bson:"blocked"`
+	BlockedBy          string       `json:"blocked_by,omitempty" bson:"blocked_by,omitempty"`
+	BlockedAt          *time.Time   `json:"blocked_at,omitempty" bson:"blocked_at,omitempty"`
+	BlockedReason      string       `json:"blocked_reason,omitempty" bson:"blocked_reason,omitempty"`
+	DeletedAt          *time.Time   `json:"deleted_at,omitempty" bson:"deleted_at,omitempty"`
+}
+
+// StatusAt returns the status the sale window gives at the time t.
+func (s Sale) StatusAt(t time.Time) SaleStatus {
+	if s.EndsAt != nil && !t.Before(*s.EndsAt) {
+		return SaleStatusExpired
+	}
+	if s.StartsAt != nil && t.Before(*s.StartsAt) {
+		return SaleStatusScheduled
+	}
+
+	return SaleStatusActive
+}
+
+// AppliesTo tells whether the sale targets a product with the given category path.
+func (s Sale) AppliesTo(productId string, categoryPath []string) bool {
+	if slices.Contains(s.ExcludedProductIds, productId) {
+		return false
+	}
+
+	switch s.Target {
+	case SaleTargetAll:
+		return true
+	case SaleTargetCategories:
+		for _, id := range categoryPath {
+			if slices.Contains(s.CategoryIds, id) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return slices.Contains(s.ProductIds, productId)
+}
+
+// AppliesToVariant tells whether the sale covers the variant of a product it applies to. An empty
+// variant id stands for the product itself, which a sale limited to variants does not cover.
+func (s Sale) AppliesToVariant(variantId string) bool {
+	return len(s.VariantIds) == 0 || slices.Contains(s.VariantIds, variantId)
+}
+
+// ProductDeletePolicy decides what happens to the sales of a product that is deleted.
+type ProductDeletePolicy string
+
+const (
+	// ProductDeleteRestrict refuses to delete a product that sales still refer to.
+	ProductDeleteRestrict ProductDeletePolicy = "restrict"
+	// ProductDeleteBlockSales blocks the sales that refer to the product.
+	ProductDeleteBlockSales ProductDeletePolicy = "block_sales"
+	// ProductDeleteSales deletes the sales left without a product and drops the product from
+	// the others.
+	ProductDeleteSales ProductDeletePolicy = "delete_sales"
+)
+
+// SaleFilter selects sales live at ActiveAt and, when ProductId is set, sales that apply to
+// that product. CategoryIds is the category path of the product. Blocked and deleted sales are
+// left out unless IncludeBlocked or IncludeDeleted is set.
+type SaleFilter struct {
+	ActiveAt       *time.Time
+	ProductId      string
+	CategoryIds    []string
+	IncludeBlocked bool
+	IncludeDeleted bool
 }
@@ -0,0 +1,86 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSaleStatusAt(t *testing.T) {
+	start := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2026, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		sale Sale
+		at   time.Time
+		want SaleStatus
+	}{
+		{"no window", Sale{}, start, SaleStatusActive},
+		{"before start", Sale{StartsAt: &start, EndsAt: &end}, start.Add(-time.Second), SaleStatusScheduled},
+		{"at start", Sale{StartsAt: &start, EndsAt: &end}, start, SaleStatusActive},
+		{"before end", Sale{StartsAt: &start, EndsAt: &end}, end.Add(-time.Second), SaleStatusActive},
+		{"at end", Sale{StartsAt: &start, EndsAt: &end}, end, SaleStatusExpired},
+		{"open start", Sale{EndsAt: &end}, start, SaleStatusActive},
+		{"open end", Sale{StartsAt: &start}, end, SaleStatusActive},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.sale.StatusAt(test.at); got != test.want {
+				t.Errorf("StatusAt(%v) = %q, want %q", test.at, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSaleAppliesToVariant(t *testing.T) {
+	tests := []struct {
+		name      string
+		sale      Sale
+		variantId string
+		want      bool
+	}{
+		{"product sale covers the product", Sale{}, "", true},
+		{"product sale covers every variant", Sale{}, "v1", true},
+		{"listed variant", Sale{VariantIds: []string{"v1", "v2"}}, "v2", true},
+		{"other variant", Sale{VariantIds: []string{"v1"}}, "v3", false},
+		{"variant sale leaves out the product", Sale{VariantIds: []string{"v1"}}, "", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.sale.AppliesToVariant(test.variantId); got != test.want {
+				t.Errorf("AppliesToVariant(%q) = %v, want %v", test.variantId, got, test.want)
+			}
+		})
+	}
+}
+
+func TestSaleAppliesTo(t *testing.T) {
+	path := []string{"root", "shoes"}
+
+	tests := []struct {
+		name      string
+		sale      Sale
+		productId string
+		want      bool
+	}{
+		{"listed product", Sale{Target: SaleTargetProducts, ProductIds: []string{"p1"}}, "p1", true},
+		{"other product", Sale{Target: SaleTargetProducts, ProductIds: []string{"p1"}}, "p2", false},
+		{"legacy sale without target", Sale{ProductIds: []string{"p1"}}, "p1", true},
+		{"category", Sale{Target: SaleTargetCategories, CategoryIds: []string{"shoes"}}, "p1", true},
+		{"ancestor category", Sale{Target: SaleTargetCategories, CategoryIds: []string{"root"}}, "p1", true},
+		{"other category", Sale{Target: SaleTargetCategories, CategoryIds: []string{"hats"}}, "p1", false},
+		{"all", Sale{Target: SaleTargetAll}, "p1", true},
+		{"excluded from all", Sale{Target: SaleTargetAll, ExcludedProductIds: []string{"p1"}}, "p1", false},
+		{"excluded from category", Sale{Target: SaleTargetCategories, CategoryIds: []string{"shoes"}, ExcludedProductIds: []string{"p1"}}, "p1", false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.sale.AppliesTo(test.productId, path); got != test.want {
+				t.Errorf("AppliesTo(%q) = %v, want %v", test.productId, got, test.want)
+			}
+		})
+	}
+}
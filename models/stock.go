@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// StockKey identifies one stock balance. An empty VariantId is the stock of the product itself.
+type StockKey struct {
+	ProductId   string `json:"product_id" bson:"product_id"`
+	WarehouseId string `json:"warehouse_id" bson:"warehouse_id"`
+	VariantId   string `json:"variant_id,omitempty" bson:"variant_id,omitempty"`
+}
+
+type StockItem struct {
+	Id        string `json:"id" bson:"_id,omitempty"`
+	StockKey  `bson:",inline"`
+	Quantity  int64     `json:"quantity" bson:"quantity"`
+	Reserved  int64     `json:"reserved" bson:"reserved"`
+	InTransit int64     `json:"in_transit" bson:"in_transit"`
+	UpdatedAt time.Time `json:"updated_at" bson:"updated_at"`
+}
+
+func (s StockItem) Available() int64 {
+	return s.Quantity - s.Reserved
+}
@@ -0,0 +1,32 @@
+package models
+
+import "time"
+
+type TransferStatus string
+
+const (
+	TransferStatusCreated  TransferStatus = "created"
+	TransferStatusShipped  TransferStatus = "shipped"
+	TransferStatusReceived TransferStatus = "received"
+)
+
+type Transfer struct {
+	Id              string         `json:"id" bson:"_id,omitempty"`
+	ProductId       string         `json:"product_id" bson:"product_id"`
+	VariantId       string         `json:"variant_id,omitempty" bson:"variant_id,omitempty"`
+	FromWarehouseId string         `json:"from_warehouse_id" bson:"from_warehouse_id"`
+	ToWarehouseId   string         `json:"to_warehouse_id" bson:"to_warehouse_id"`
+	Quantity        int64          `json:"quantity" bson:"quantity"`
+	Status          TransferStatus `json:"status" bson:"status"`
+	CreatedAt       time.Time      `json:"created_at" bson:"created_at"`
+	ShippedAt       *time.Time     `json:"shipped_at,omitempty" bson:"shipped_at,omitempty"`
+	ReceivedAt      *time.Time     `json:"received_at,omitempty" bson:"received_at,omitempty"`
+}
+
+func (t Transfer) Source() StockKey {
+	return StockKey{ProductId: t.ProductId, WarehouseId: t.FromWarehouseId, VariantId: t.VariantId}
+}
+
+func (t Transfer) Destination() StockKey {
+	return StockKey{ProductId: t.ProductId, WarehouseId: t.ToWarehouseId, VariantId: t.VariantId}
+}
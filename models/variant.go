@@ -0,0 +1,20 @@
+package models
+
+// Variant is a sellable version of a product, for example one size and colour. Price overrides
+// the product price when set.
+type Variant struct {
+	Id          string            `json:"id" bson:"_id,omitempty"`
+	ProductId   string            `json:"product_id" bson:"product_id"`
+	ProductCode string            `json:"product_code" bson:"product_code"`
+	Attributes  map[string]string `json:"attributes" bson:"attributes"`
+	Price       *Money            `json:"price,omitempty" bson:"price,omitempty"`
+	Barcode     string            `json:"barcode,omitempty" bson:"barcode,omitempty"`
+}
+
+func (v Variant) EffectivePrice(product Product) Money {
+	if v.Price != nil {
+		return *v.Price
+	}
+
+	return product.Price
+}
@@ -0,0 +1,8 @@
+package models
+
+type Warehouse struct {
+	Id      string `json:"id" bson:"_id,omitempty"`
+	Name    string `json:"name" bson:"name"`
+	Address string `json:"address" bson:"address"`
+	Blocked bool   `json:"blocked" bson:"blocked"`
+}
@@ -95,7 +95,7 @@ exitLoop:
 		}
 	}
 
-	err = Migrate(ctx, client, options.Database, options.MigrationsPath, logger)
+	err = Migrate(ctx, client, options, logger)
 	if err != nil {
 		logger.Fatal().Err(err).Msgf("Failed to migrate")
 	}
@@ -105,8 +105,21 @@ exitLoop:
 
 }
 
-func Migrate(ctx context.Context, client *mongo.Client, databaseName string, migrationPath string, logger zerolog.Logger) error {
-	migrations := make(map[uint]scripts.Migration)
+func Migrate(ctx context.Context, client *mongo.Client, options config.DatabaseConfig, logger zerolog.Logger) error {
+	var (
+		databaseName  = options.Database
+		migrationPath = options.MigrationsPath
+	)
+
+	// Versions listed here run Go code after their .up.json file, for steps that depend on configuration.
+	// The steps are safe to repeat and run on every start, so a step that failed after migrate had
+	// recorded its version is finished on the next start.
+	migrations := map[uint]scripts.Migration{
+		10: scripts.ProductSearch{Language: options.TextSearchLanguage},
+		14: scripts.MoneyPrices{Currency: options.PriceCurrency},
+		15: scripts.PriceHistory{},
+		25: scripts.StockWarehouses{},
+	}
 
 	db := client.Database(databaseName)
 
@@ -145,26 +158,22 @@ func Migrate(ctx context.Context, client *mongo.Client, databaseName string, mig
 			return err
 		}
 
-		if uint(version) <= actualVersion {
-			continue
-		}
-
-		err = m.Migrate(uint(version))
-		if err != nil {
-			logger.Error().Err(err).Msgf("Failed to migrate")
-			return err
+		if uint(version) > actualVersion {
+			err = m.Migrate(uint(version))
+			if err != nil {
+				logger.Error().Err(err).Msgf("Failed to migrate")
+				return err
+			}
+			logger.Info().Msgf("Migrated migration %d", version)
 		}
 
 		migration, ok := migrations[uint(version)]
 		if ok {
-			logger.Info().Msgf("Migrated migration %d", version)
 			err = migration.Up(ctx, db)
 			if err != nil {
-				logger.Error().Err(err).Msgf("Failed to migrate")
+				logger.Error().Err(err).Msgf("Failed to run migration %d script", version)
 				return err
 			}
-		} else {
-			logger.Info().Msgf("Migrated migration %d", version)
 		}
 	}
 
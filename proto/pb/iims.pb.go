@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,31 +23,6310 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ProductSortField int32
+
+const (
+	ProductSortField_PRODUCT_SORT_FIELD_UNSPECIFIED   ProductSortField = 0
+	ProductSortField_PRODUCT_SORT_FIELD_NAME          ProductSortField = 1
+	ProductSortField_PRODUCT_SORT_FIELD_PRICE         ProductSortField = 2
+	ProductSortField_PRODUCT_SORT_FIELD_CREATION_DATE ProductSortField = 3
+)
+
+// Enum value maps for ProductSortField.
+var (
+	ProductSortField_name = map[int32]string{
+		0: "PRODUCT_SORT_FIELD_UNSPECIFIED",
+		1: "PRODUCT_SORT_FIELD_NAME",
+		2: "PRODUCT_SORT_FIELD_PRICE",
+		3: "PRODUCT_SORT_FIELD_CREATION_DATE",
+	}
+	ProductSortField_value = map[string]int32{
+		"PRODUCT_SORT_FIELD_UNSPECIFIED":   0,
+		"PRODUCT_SORT_FIELD_NAME":          1,
+		"PRODUCT_SORT_FIELD_PRICE":         2,
+		"PRODUCT_SORT_FIELD_CREATION_DATE": 3,
+	}
+)
+
+func (x ProductSortField) Enum() *ProductSortField {
+	p := new(ProductSortField)
+	*p = x
+	return p
+}
+
+func (x ProductSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ProductSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[0].Descriptor()
+}
+
+func (ProductSortField) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[0]
+}
+
+func (x ProductSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ProductSortField.Descriptor instead.
+func (ProductSortField) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{0}
+}
+
+type SortDirection int32
+
+const (
+	SortDirection_SORT_DIRECTION_ASC  SortDirection = 0
+	SortDirection_SORT_DIRECTION_DESC SortDirection = 1
+)
+
+// Enum value maps for SortDirection.
+var (
+	SortDirection_name = map[int32]string{
+		0: "SORT_DIRECTION_ASC",
+		1: "SORT_DIRECTION_DESC",
+	}
+	SortDirection_value = map[string]int32{
+		"SORT_DIRECTION_ASC":  0,
+		"SORT_DIRECTION_DESC": 1,
+	}
+)
+
+func (x SortDirection) Enum() *SortDirection {
+	p := new(SortDirection)
+	*p = x
+	return p
+}
+
+func (x SortDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[1].Descriptor()
+}
+
+func (SortDirection) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[1]
+}
+
+func (x SortDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortDirection.Descriptor instead.
+func (SortDirection) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{1}
+}
+
+type PriceChangeStatus int32
+
+const (
+	PriceChangeStatus_PRICE_CHANGE_STATUS_UNSPECIFIED PriceChangeStatus = 0
+	PriceChangeStatus_PRICE_CHANGE_STATUS_SCHEDULED   PriceChangeStatus = 1
+	PriceChangeStatus_PRICE_CHANGE_STATUS_APPLIED     PriceChangeStatus = 2
+	PriceChangeStatus_PRICE_CHANGE_STATUS_FAILED      PriceChangeStatus = 3
+)
+
+// Enum value maps for PriceChangeStatus.
+var (
+	PriceChangeStatus_name = map[int32]string{
+		0: "PRICE_CHANGE_STATUS_UNSPECIFIED",
+		1: "PRICE_CHANGE_STATUS_SCHEDULED",
+		2: "PRICE_CHANGE_STATUS_APPLIED",
+		3: "PRICE_CHANGE_STATUS_FAILED",
+	}
+	PriceChangeStatus_value = map[string]int32{
+		"PRICE_CHANGE_STATUS_UNSPECIFIED": 0,
+		"PRICE_CHANGE_STATUS_SCHEDULED":   1,
+		"PRICE_CHANGE_STATUS_APPLIED":     2,
+		"PRICE_CHANGE_STATUS_FAILED":      3,
+	}
+)
+
+func (x PriceChangeStatus) Enum() *PriceChangeStatus {
+	p := new(PriceChangeStatus)
+	*p = x
+	return p
+}
+
+func (x PriceChangeStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PriceChangeStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[2].Descriptor()
+}
+
+func (PriceChangeStatus) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[2]
+}
+
+func (x PriceChangeStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PriceChangeStatus.Descriptor instead.
+func (PriceChangeStatus) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{2}
+}
+
+type SaleStatus int32
+
+const (
+	SaleStatus_SALE_STATUS_UNSPECIFIED SaleStatus = 0
+	SaleStatus_SALE_STATUS_SCHEDULED   SaleStatus = 1
+	SaleStatus_SALE_STATUS_ACTIVE      SaleStatus = 2
+	SaleStatus_SALE_STATUS_EXPIRED     SaleStatus = 3
+)
+
+// Enum value maps for SaleStatus.
+var (
+	SaleStatus_name = map[int32]string{
+		0: "SALE_STATUS_UNSPECIFIED",
+		1: "SALE_STATUS_SCHEDULED",
+		2: "SALE_STATUS_ACTIVE",
+		3: "SALE_STATUS_EXPIRED",
+	}
+	SaleStatus_value = map[string]int32{
+		"SALE_STATUS_UNSPECIFIED": 0,
+		"SALE_STATUS_SCHEDULED":   1,
+		"SALE_STATUS_ACTIVE":      2,
+		"SALE_STATUS_EXPIRED":     3,
+	}
+)
+
+func (x SaleStatus) Enum() *SaleStatus {
+	p := new(SaleStatus)
+	*p = x
+	return p
+}
+
+func (x SaleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SaleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[3].Descriptor()
+}
+
+func (SaleStatus) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[3]
+}
+
+func (x SaleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SaleStatus.Descriptor instead.
+func (SaleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{3}
+}
+
+type DiscountType int32
+
+const (
+	DiscountType_DISCOUNT_TYPE_UNSPECIFIED  DiscountType = 0
+	DiscountType_DISCOUNT_TYPE_PERCENTAGE   DiscountType = 1
+	DiscountType_DISCOUNT_TYPE_FIXED_AMOUNT DiscountType = 2
+	DiscountType_DISCOUNT_TYPE_FIXED_PRICE  DiscountType = 3
+	DiscountType_DISCOUNT_TYPE_BUY_X_GET_Y  DiscountType = 4
+	DiscountType_DISCOUNT_TYPE_BUNDLE       DiscountType = 5
+)
+
+// Enum value maps for DiscountType.
+var (
+	DiscountType_name = map[int32]string{
+		0: "DISCOUNT_TYPE_UNSPECIFIED",
+		1: "DISCOUNT_TYPE_PERCENTAGE",
+		2: "DISCOUNT_TYPE_FIXED_AMOUNT",
+		3: "DISCOUNT_TYPE_FIXED_PRICE",
+		4: "DISCOUNT_TYPE_BUY_X_GET_Y",
+		5: "DISCOUNT_TYPE_BUNDLE",
+	}
+	DiscountType_value = map[string]int32{
+		"DISCOUNT_TYPE_UNSPECIFIED":  0,
+		"DISCOUNT_TYPE_PERCENTAGE":   1,
+		"DISCOUNT_TYPE_FIXED_AMOUNT": 2,
+		"DISCOUNT_TYPE_FIXED_PRICE":  3,
+		"DISCOUNT_TYPE_BUY_X_GET_Y":  4,
+		"DISCOUNT_TYPE_BUNDLE":       5,
+	}
+)
+
+func (x DiscountType) Enum() *DiscountType {
+	p := new(DiscountType)
+	*p = x
+	return p
+}
+
+func (x DiscountType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscountType) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[4].Descriptor()
+}
+
+func (DiscountType) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[4]
+}
+
+func (x DiscountType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscountType.Descriptor instead.
+func (DiscountType) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{4}
+}
+
+type SaleTarget int32
+
+const (
+	SaleTarget_SALE_TARGET_UNSPECIFIED SaleTarget = 0
+	SaleTarget_SALE_TARGET_PRODUCTS    SaleTarget = 1
+	SaleTarget_SALE_TARGET_CATEGORIES  SaleTarget = 2
+	SaleTarget_SALE_TARGET_ALL         SaleTarget = 3
+)
+
+// Enum value maps for SaleTarget.
+var (
+	SaleTarget_name = map[int32]string{
+		0: "SALE_TARGET_UNSPECIFIED",
+		1: "SALE_TARGET_PRODUCTS",
+		2: "SALE_TARGET_CATEGORIES",
+		3: "SALE_TARGET_ALL",
+	}
+	SaleTarget_value = map[string]int32{
+		"SALE_TARGET_UNSPECIFIED": 0,
+		"SALE_TARGET_PRODUCTS":    1,
+		"SALE_TARGET_CATEGORIES":  2,
+		"SALE_TARGET_ALL":         3,
+	}
+)
+
+func (x SaleTarget) Enum() *SaleTarget {
+	p := new(SaleTarget)
+	*p = x
+	return p
+}
+
+func (x SaleTarget) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SaleTarget) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[5].Descriptor()
+}
+
+func (SaleTarget) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[5]
+}
+
+func (x SaleTarget) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SaleTarget.Descriptor instead.
+func (SaleTarget) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{5}
+}
+
+type SaleCombinePolicy int32
+
+const (
+	SaleCombinePolicy_SALE_COMBINE_POLICY_UNSPECIFIED SaleCombinePolicy = 0
+	SaleCombinePolicy_SALE_COMBINE_POLICY_BEST_OF     SaleCombinePolicy = 1
+	SaleCombinePolicy_SALE_COMBINE_POLICY_STACK       SaleCombinePolicy = 2
+	SaleCombinePolicy_SALE_COMBINE_POLICY_EXCLUSIVE   SaleCombinePolicy = 3
+)
+
+// Enum value maps for SaleCombinePolicy.
+var (
+	SaleCombinePolicy_name = map[int32]string{
+		0: "SALE_COMBINE_POLICY_UNSPECIFIED",
+		1: "SALE_COMBINE_POLICY_BEST_OF",
+		2: "SALE_COMBINE_POLICY_STACK",
+		3: "SALE_COMBINE_POLICY_EXCLUSIVE",
+	}
+	SaleCombinePolicy_value = map[string]int32{
+		"SALE_COMBINE_POLICY_UNSPECIFIED": 0,
+		"SALE_COMBINE_POLICY_BEST_OF":     1,
+		"SALE_COMBINE_POLICY_STACK":       2,
+		"SALE_COMBINE_POLICY_EXCLUSIVE":   3,
+	}
+)
+
+func (x SaleCombinePolicy) Enum() *SaleCombinePolicy {
+	p := new(SaleCombinePolicy)
+	*p = x
+	return p
+}
+
+func (x SaleCombinePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SaleCombinePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[6].Descriptor()
+}
+
+func (SaleCombinePolicy) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[6]
+}
+
+func (x SaleCombinePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SaleCombinePolicy.Descriptor instead.
+func (SaleCombinePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{6}
+}
+
+type MovementReason int32
+
+const (
+	MovementReason_MOVEMENT_REASON_UNSPECIFIED MovementReason = 0
+	MovementReason_MOVEMENT_REASON_RECEIPT     MovementReason = 1
+	MovementReason_MOVEMENT_REASON_SALE        MovementReason = 2
+	MovementReason_MOVEMENT_REASON_ADJUSTMENT  MovementReason = 3
+	MovementReason_MOVEMENT_REASON_TRANSFER    MovementReason = 4
+	MovementReason_MOVEMENT_REASON_WRITE_OFF   MovementReason = 5
+	// MOVEMENT_REASON_OPENING_BALANCE books the quantities held before the ledger was introduced.
+	// It cannot be used for adjustments.
+	MovementReason_MOVEMENT_REASON_OPENING_BALANCE MovementReason = 6
+)
+
+// Enum value maps for MovementReason.
+var (
+	MovementReason_name = map[int32]string{
+		0: "MOVEMENT_REASON_UNSPECIFIED",
+		1: "MOVEMENT_REASON_RECEIPT",
+		2: "MOVEMENT_REASON_SALE",
+		3: "MOVEMENT_REASON_ADJUSTMENT",
+		4: "MOVEMENT_REASON_TRANSFER",
+		5: "MOVEMENT_REASON_WRITE_OFF",
+		6: "MOVEMENT_REASON_OPENING_BALANCE",
+	}
+	MovementReason_value = map[string]int32{
+		"MOVEMENT_REASON_UNSPECIFIED":     0,
+		"MOVEMENT_REASON_RECEIPT":         1,
+		"MOVEMENT_REASON_SALE":            2,
+		"MOVEMENT_REASON_ADJUSTMENT":      3,
+		"MOVEMENT_REASON_TRANSFER":        4,
+		"MOVEMENT_REASON_WRITE_OFF":       5,
+		"MOVEMENT_REASON_OPENING_BALANCE": 6,
+	}
+)
+
+func (x MovementReason) Enum() *MovementReason {
+	p := new(MovementReason)
+	*p = x
+	return p
+}
+
+func (x MovementReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MovementReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[7].Descriptor()
+}
+
+func (MovementReason) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[7]
+}
+
+func (x MovementReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MovementReason.Descriptor instead.
+func (MovementReason) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{7}
+}
+
+type TransferStatus int32
+
+const (
+	TransferStatus_TRANSFER_STATUS_UNSPECIFIED TransferStatus = 0
+	TransferStatus_TRANSFER_STATUS_CREATED     TransferStatus = 1
+	TransferStatus_TRANSFER_STATUS_SHIPPED     TransferStatus = 2
+	TransferStatus_TRANSFER_STATUS_RECEIVED    TransferStatus = 3
+)
+
+// Enum value maps for TransferStatus.
+var (
+	TransferStatus_name = map[int32]string{
+		0: "TRANSFER_STATUS_UNSPECIFIED",
+		1: "TRANSFER_STATUS_CREATED",
+		2: "TRANSFER_STATUS_SHIPPED",
+		3: "TRANSFER_STATUS_RECEIVED",
+	}
+	TransferStatus_value = map[string]int32{
+		"TRANSFER_STATUS_UNSPECIFIED": 0,
+		"TRANSFER_STATUS_CREATED":     1,
+		"TRANSFER_STATUS_SHIPPED":     2,
+		"TRANSFER_STATUS_RECEIVED":    3,
+	}
+)
+
+func (x TransferStatus) Enum() *TransferStatus {
+	p := new(TransferStatus)
+	*p = x
+	return p
+}
+
+func (x TransferStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[8].Descriptor()
+}
+
+func (TransferStatus) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[8]
+}
+
+func (x TransferStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferStatus.Descriptor instead.
+func (TransferStatus) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{8}
+}
+
+type AttributeType int32
+
+const (
+	AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED AttributeType = 0
+	AttributeType_ATTRIBUTE_TYPE_STRING      AttributeType = 1
+	AttributeType_ATTRIBUTE_TYPE_NUMBER      AttributeType = 2
+	AttributeType_ATTRIBUTE_TYPE_BOOL        AttributeType = 3
+	AttributeType_ATTRIBUTE_TYPE_ENUM        AttributeType = 4
+)
+
+// Enum value maps for AttributeType.
+var (
+	AttributeType_name = map[int32]string{
+		0: "ATTRIBUTE_TYPE_UNSPECIFIED",
+		1: "ATTRIBUTE_TYPE_STRING",
+		2: "ATTRIBUTE_TYPE_NUMBER",
+		3: "ATTRIBUTE_TYPE_BOOL",
+		4: "ATTRIBUTE_TYPE_ENUM",
+	}
+	AttributeType_value = map[string]int32{
+		"ATTRIBUTE_TYPE_UNSPECIFIED": 0,
+		"ATTRIBUTE_TYPE_STRING":      1,
+		"ATTRIBUTE_TYPE_NUMBER":      2,
+		"ATTRIBUTE_TYPE_BOOL":        3,
+		"ATTRIBUTE_TYPE_ENUM":        4,
+	}
+)
+
+func (x AttributeType) Enum() *AttributeType {
+	p := new(AttributeType)
+	*p = x
+	return p
+}
+
+func (x AttributeType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeType) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[9].Descriptor()
+}
+
+func (AttributeType) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[9]
+}
+
+func (x AttributeType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeType.Descriptor instead.
+func (AttributeType) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{9}
+}
+
+type SalesChannel int32
+
+const (
+	SalesChannel_SALES_CHANNEL_UNSPECIFIED SalesChannel = 0
+	SalesChannel_SALES_CHANNEL_RETAIL      SalesChannel = 1
+	SalesChannel_SALES_CHANNEL_WHOLESALE   SalesChannel = 2
+	SalesChannel_SALES_CHANNEL_ONLINE      SalesChannel = 3
+)
+
+// Enum value maps for SalesChannel.
+var (
+	SalesChannel_name = map[int32]string{
+		0: "SALES_CHANNEL_UNSPECIFIED",
+		1: "SALES_CHANNEL_RETAIL",
+		2: "SALES_CHANNEL_WHOLESALE",
+		3: "SALES_CHANNEL_ONLINE",
+	}
+	SalesChannel_value = map[string]int32{
+		"SALES_CHANNEL_UNSPECIFIED": 0,
+		"SALES_CHANNEL_RETAIL":      1,
+		"SALES_CHANNEL_WHOLESALE":   2,
+		"SALES_CHANNEL_ONLINE":      3,
+	}
+)
+
+func (x SalesChannel) Enum() *SalesChannel {
+	p := new(SalesChannel)
+	*p = x
+	return p
+}
+
+func (x SalesChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SalesChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[10].Descriptor()
+}
+
+func (SalesChannel) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[10]
+}
+
+func (x SalesChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SalesChannel.Descriptor instead.
+func (SalesChannel) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{10}
+}
+
+type CouponRejection int32
+
+const (
+	CouponRejection_COUPON_REJECTION_UNSPECIFIED    CouponRejection = 0
+	CouponRejection_COUPON_REJECTION_EXPIRED        CouponRejection = 1
+	CouponRejection_COUPON_REJECTION_EXHAUSTED      CouponRejection = 2
+	CouponRejection_COUPON_REJECTION_CUSTOMER_LIMIT CouponRejection = 3
+	CouponRejection_COUPON_REJECTION_SALE_INACTIVE  CouponRejection = 4
+)
+
+// Enum value maps for CouponRejection.
+var (
+	CouponRejection_name = map[int32]string{
+		0: "COUPON_REJECTION_UNSPECIFIED",
+		1: "COUPON_REJECTION_EXPIRED",
+		2: "COUPON_REJECTION_EXHAUSTED",
+		3: "COUPON_REJECTION_CUSTOMER_LIMIT",
+		4: "COUPON_REJECTION_SALE_INACTIVE",
+	}
+	CouponRejection_value = map[string]int32{
+		"COUPON_REJECTION_UNSPECIFIED":    0,
+		"COUPON_REJECTION_EXPIRED":        1,
+		"COUPON_REJECTION_EXHAUSTED":      2,
+		"COUPON_REJECTION_CUSTOMER_LIMIT": 3,
+		"COUPON_REJECTION_SALE_INACTIVE":  4,
+	}
+)
+
+func (x CouponRejection) Enum() *CouponRejection {
+	p := new(CouponRejection)
+	*p = x
+	return p
+}
+
+func (x CouponRejection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CouponRejection) Descriptor() protoreflect.EnumDescriptor {
+	return file_iims_proto_enumTypes[11].Descriptor()
+}
+
+func (CouponRejection) Type() protoreflect.EnumType {
+	return &file_iims_proto_enumTypes[11]
+}
+
+func (x CouponRejection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CouponRejection.Descriptor instead.
+func (CouponRejection) EnumDescriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{11}
+}
+
+// Money is an exact amount in minor units of an ISO 4217 currency, e.g. 2499 RUB is 24.99 rubles.
+type Money struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Amount        int64                  `protobuf:"varint,1,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=Currency,proto3" json:"Currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	mi := &file_iims_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type InsertProductRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	CreationDate  string                 `protobuf:"bytes,3,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
-	Price         float32                `protobuf:"fixed32,4,opt,name=Price,proto3" json:"Price,omitempty"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	CreationDate  string                 `protobuf:"bytes,3,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
+	ProductCode   string                 `protobuf:"bytes,5,opt,name=ProductCode,proto3" json:"ProductCode,omitempty"`
+	Barcodes      []string               `protobuf:"bytes,6,rep,name=Barcodes,proto3" json:"Barcodes,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,7,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,8,rep,name=Attributes,proto3" json:"Attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price         *Money                 `protobuf:"bytes,9,opt,name=Price,proto3" json:"Price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertProductRequest) Reset() {
+	*x = InsertProductRequest{}
+	mi := &file_iims_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertProductRequest) ProtoMessage() {}
+
+func (x *InsertProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertProductRequest.ProtoReflect.Descriptor instead.
+func (*InsertProductRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *InsertProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsertProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InsertProductRequest) GetCreationDate() string {
+	if x != nil {
+		return x.CreationDate
+	}
+	return ""
+}
+
+func (x *InsertProductRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InsertProductRequest) GetBarcodes() []string {
+	if x != nil {
+		return x.Barcodes
+	}
+	return nil
+}
+
+func (x *InsertProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *InsertProductRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *InsertProductRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type GetByProductCodeRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=IncludeDeleted,proto3" json:"IncludeDeleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetByProductCodeRequest) Reset() {
+	*x = GetByProductCodeRequest{}
+	mi := &file_iims_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByProductCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByProductCodeRequest) ProtoMessage() {}
+
+func (x *GetByProductCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByProductCodeRequest.ProtoReflect.Descriptor instead.
+func (*GetByProductCodeRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetByProductCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *GetByProductCodeRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type GetByBarcodeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByBarcodeRequest) Reset() {
+	*x = GetByBarcodeRequest{}
+	mi := &file_iims_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByBarcodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByBarcodeRequest) ProtoMessage() {}
+
+func (x *GetByBarcodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByBarcodeRequest.ProtoReflect.Descriptor instead.
+func (*GetByBarcodeRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetByBarcodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type SearchProductsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Query          string                 `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Limit          int64                  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset         int64                  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	IncludeBlocked bool                   `protobuf:"varint,4,opt,name=IncludeBlocked,proto3" json:"IncludeBlocked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SearchProductsRequest) Reset() {
+	*x = SearchProductsRequest{}
+	mi := &file_iims_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsRequest) ProtoMessage() {}
+
+func (x *SearchProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsRequest.ProtoReflect.Descriptor instead.
+func (*SearchProductsRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SearchProductsRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *SearchProductsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *SearchProductsRequest) GetIncludeBlocked() bool {
+	if x != nil {
+		return x.IncludeBlocked
+	}
+	return false
+}
+
+type ScoredProductMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *GetProductMessage     `protobuf:"bytes,1,opt,name=Product,proto3" json:"Product,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=Score,proto3" json:"Score,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoredProductMessage) Reset() {
+	*x = ScoredProductMessage{}
+	mi := &file_iims_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoredProductMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoredProductMessage) ProtoMessage() {}
+
+func (x *ScoredProductMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoredProductMessage.ProtoReflect.Descriptor instead.
+func (*ScoredProductMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ScoredProductMessage) GetProduct() *GetProductMessage {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+func (x *ScoredProductMessage) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type SearchProductsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Results       []*ScoredProductMessage `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchProductsResponse) Reset() {
+	*x = SearchProductsResponse{}
+	mi := &file_iims_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchProductsResponse) ProtoMessage() {}
+
+func (x *SearchProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchProductsResponse.ProtoReflect.Descriptor instead.
+func (*SearchProductsResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchProductsResponse) GetResults() []*ScoredProductMessage {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type GetByIdProductRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IncludeDeleted bool                   `protobuf:"varint,2,opt,name=IncludeDeleted,proto3" json:"IncludeDeleted,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetByIdProductRequest) Reset() {
+	*x = GetByIdProductRequest{}
+	mi := &file_iims_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdProductRequest) ProtoMessage() {}
+
+func (x *GetByIdProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdProductRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdProductRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetByIdProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetByIdProductRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+type InsertProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertProductResponse) Reset() {
+	*x = InsertProductResponse{}
+	mi := &file_iims_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertProductResponse) ProtoMessage() {}
+
+func (x *InsertProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertProductResponse.ProtoReflect.Descriptor instead.
+func (*InsertProductResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *InsertProductResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetProductsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Limit is the page size: 50 when it is zero, and at most 1000.
+	Limit        int64  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset       int64  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	NameContains string `protobuf:"bytes,3,opt,name=NameContains,proto3" json:"NameContains,omitempty"`
+	// Blocked keeps only blocked or only unblocked products. When it is not set, blocked products
+	// are left out unless IncludeBlocked is set.
+	Blocked           *bool                  `protobuf:"varint,6,opt,name=Blocked,proto3,oneof" json:"Blocked,omitempty"`
+	CreatedFrom       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedFrom,proto3" json:"CreatedFrom,omitempty"`
+	CreatedTo         *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=CreatedTo,proto3" json:"CreatedTo,omitempty"`
+	SortField         ProductSortField       `protobuf:"varint,9,opt,name=SortField,proto3,enum=iims.ProductSortField" json:"SortField,omitempty"`
+	SortDirection     SortDirection          `protobuf:"varint,10,opt,name=SortDirection,proto3,enum=iims.SortDirection" json:"SortDirection,omitempty"`
+	PageToken         string                 `protobuf:"bytes,11,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	IncludeTotalCount bool                   `protobuf:"varint,12,opt,name=IncludeTotalCount,proto3" json:"IncludeTotalCount,omitempty"`
+	CategoryId        string                 `protobuf:"bytes,13,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	// Attributes must be defined for the category, its ancestors or its subcategories when
+	// CategoryId is set.
+	Attributes     map[string]string `protobuf:"bytes,14,rep,name=Attributes,proto3" json:"Attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	MinPrice       *Money            `protobuf:"bytes,15,opt,name=MinPrice,proto3" json:"MinPrice,omitempty"`
+	MaxPrice       *Money            `protobuf:"bytes,16,opt,name=MaxPrice,proto3" json:"MaxPrice,omitempty"`
+	IncludeDeleted bool              `protobuf:"varint,17,opt,name=IncludeDeleted,proto3" json:"IncludeDeleted,omitempty"`
+	IncludeBlocked bool              `protobuf:"varint,18,opt,name=IncludeBlocked,proto3" json:"IncludeBlocked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetProductsRequest) Reset() {
+	*x = GetProductsRequest{}
+	mi := &file_iims_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsRequest) ProtoMessage() {}
+
+func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsRequest.ProtoReflect.Descriptor instead.
+func (*GetProductsRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetProductsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetProductsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetProductsRequest) GetNameContains() string {
+	if x != nil {
+		return x.NameContains
+	}
+	return ""
+}
+
+func (x *GetProductsRequest) GetBlocked() bool {
+	if x != nil && x.Blocked != nil {
+		return *x.Blocked
+	}
+	return false
+}
+
+func (x *GetProductsRequest) GetCreatedFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedFrom
+	}
+	return nil
+}
+
+func (x *GetProductsRequest) GetCreatedTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTo
+	}
+	return nil
+}
+
+func (x *GetProductsRequest) GetSortField() ProductSortField {
+	if x != nil {
+		return x.SortField
+	}
+	return ProductSortField_PRODUCT_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *GetProductsRequest) GetSortDirection() SortDirection {
+	if x != nil {
+		return x.SortDirection
+	}
+	return SortDirection_SORT_DIRECTION_ASC
+}
+
+func (x *GetProductsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetProductsRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *GetProductsRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetProductsRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetProductsRequest) GetMinPrice() *Money {
+	if x != nil {
+		return x.MinPrice
+	}
+	return nil
+}
+
+func (x *GetProductsRequest) GetMaxPrice() *Money {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return nil
+}
+
+func (x *GetProductsRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetProductsRequest) GetIncludeBlocked() bool {
+	if x != nil {
+		return x.IncludeBlocked
+	}
+	return false
+}
+
+type GetProductMessage struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	CreationDate string                 `protobuf:"bytes,4,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
+	ProductCode  string                 `protobuf:"bytes,6,opt,name=ProductCode,proto3" json:"ProductCode,omitempty"`
+	Barcodes     []string               `protobuf:"bytes,7,rep,name=Barcodes,proto3" json:"Barcodes,omitempty"`
+	CategoryId   string                 `protobuf:"bytes,8,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	Variants     []*VariantMessage      `protobuf:"bytes,9,rep,name=Variants,proto3" json:"Variants,omitempty"`
+	VariantId    string                 `protobuf:"bytes,10,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	Attributes   map[string]string      `protobuf:"bytes,11,rep,name=Attributes,proto3" json:"Attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Price        *Money                 `protobuf:"bytes,12,opt,name=Price,proto3" json:"Price,omitempty"`
+	// DeletedAt is set only for a deleted product that has not been purged yet.
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=DeletedAt,proto3" json:"DeletedAt,omitempty"`
+	Blocked       bool                   `protobuf:"varint,14,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+	BlockedBy     string                 `protobuf:"bytes,15,opt,name=BlockedBy,proto3" json:"BlockedBy,omitempty"`
+	BlockedAt     *timestamppb.Timestamp `protobuf:"bytes,16,opt,name=BlockedAt,proto3" json:"BlockedAt,omitempty"`
+	BlockedReason string                 `protobuf:"bytes,17,opt,name=BlockedReason,proto3" json:"BlockedReason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductMessage) Reset() {
+	*x = GetProductMessage{}
+	mi := &file_iims_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductMessage) ProtoMessage() {}
+
+func (x *GetProductMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductMessage.ProtoReflect.Descriptor instead.
+func (*GetProductMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetProductMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetProductMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetProductMessage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetProductMessage) GetCreationDate() string {
+	if x != nil {
+		return x.CreationDate
+	}
+	return ""
+}
+
+func (x *GetProductMessage) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *GetProductMessage) GetBarcodes() []string {
+	if x != nil {
+		return x.Barcodes
+	}
+	return nil
+}
+
+func (x *GetProductMessage) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetProductMessage) GetVariants() []*VariantMessage {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+func (x *GetProductMessage) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+func (x *GetProductMessage) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *GetProductMessage) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *GetProductMessage) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *GetProductMessage) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *GetProductMessage) GetBlockedBy() string {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return ""
+}
+
+func (x *GetProductMessage) GetBlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return nil
+}
+
+func (x *GetProductMessage) GetBlockedReason() string {
+	if x != nil {
+		return x.BlockedReason
+	}
+	return ""
+}
+
+type VariantMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId      string                 `protobuf:"bytes,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	ProductCode    string                 `protobuf:"bytes,3,opt,name=ProductCode,proto3" json:"ProductCode,omitempty"`
+	Attributes     map[string]string      `protobuf:"bytes,4,rep,name=Attributes,proto3" json:"Attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Barcode        string                 `protobuf:"bytes,7,opt,name=Barcode,proto3" json:"Barcode,omitempty"`
+	Price          *Money                 `protobuf:"bytes,8,opt,name=Price,proto3" json:"Price,omitempty"`
+	EffectivePrice *Money                 `protobuf:"bytes,9,opt,name=EffectivePrice,proto3" json:"EffectivePrice,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *VariantMessage) Reset() {
+	*x = VariantMessage{}
+	mi := &file_iims_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VariantMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VariantMessage) ProtoMessage() {}
+
+func (x *VariantMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VariantMessage.ProtoReflect.Descriptor instead.
+func (*VariantMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *VariantMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *VariantMessage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *VariantMessage) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *VariantMessage) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *VariantMessage) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *VariantMessage) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *VariantMessage) GetEffectivePrice() *Money {
+	if x != nil {
+		return x.EffectivePrice
+	}
+	return nil
+}
+
+type InsertVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=ProductCode,proto3" json:"ProductCode,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=Attributes,proto3" json:"Attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Barcode       string                 `protobuf:"bytes,5,opt,name=Barcode,proto3" json:"Barcode,omitempty"`
+	Price         *Money                 `protobuf:"bytes,6,opt,name=Price,proto3" json:"Price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertVariantRequest) Reset() {
+	*x = InsertVariantRequest{}
+	mi := &file_iims_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertVariantRequest) ProtoMessage() {}
+
+func (x *InsertVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertVariantRequest.ProtoReflect.Descriptor instead.
+func (*InsertVariantRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *InsertVariantRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *InsertVariantRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *InsertVariantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *InsertVariantRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *InsertVariantRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type InsertVariantResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertVariantResponse) Reset() {
+	*x = InsertVariantResponse{}
+	mi := &file_iims_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertVariantResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertVariantResponse) ProtoMessage() {}
+
+func (x *InsertVariantResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertVariantResponse.ProtoReflect.Descriptor instead.
+func (*InsertVariantResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *InsertVariantResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductCode   string                 `protobuf:"bytes,2,opt,name=ProductCode,proto3" json:"ProductCode,omitempty"`
+	Attributes    map[string]string      `protobuf:"bytes,3,rep,name=Attributes,proto3" json:"Attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Barcode       string                 `protobuf:"bytes,5,opt,name=Barcode,proto3" json:"Barcode,omitempty"`
+	Price         *Money                 `protobuf:"bytes,6,opt,name=Price,proto3" json:"Price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVariantRequest) Reset() {
+	*x = UpdateVariantRequest{}
+	mi := &file_iims_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVariantRequest) ProtoMessage() {}
+
+func (x *UpdateVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVariantRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVariantRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *UpdateVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateVariantRequest) GetBarcode() string {
+	if x != nil {
+		return x.Barcode
+	}
+	return ""
+}
+
+func (x *UpdateVariantRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type DeleteVariantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVariantRequest) Reset() {
+	*x = DeleteVariantRequest{}
+	mi := &file_iims_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVariantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVariantRequest) ProtoMessage() {}
+
+func (x *DeleteVariantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVariantRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVariantRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *DeleteVariantRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type SchedulePriceChangeRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	// Price must be in the product currency.
+	Price         *Money                 `protobuf:"bytes,2,opt,name=Price,proto3" json:"Price,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=EffectiveFrom,proto3" json:"EffectiveFrom,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SchedulePriceChangeRequest) Reset() {
+	*x = SchedulePriceChangeRequest{}
+	mi := &file_iims_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulePriceChangeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulePriceChangeRequest) ProtoMessage() {}
+
+func (x *SchedulePriceChangeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulePriceChangeRequest.ProtoReflect.Descriptor instead.
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SchedulePriceChangeRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *SchedulePriceChangeRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *SchedulePriceChangeRequest) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+type GetPriceAtRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	// At is required; the current price is the one on the product.
+	At            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=At,proto3" json:"At,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPriceAtRequest) Reset() {
+	*x = GetPriceAtRequest{}
+	mi := &file_iims_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPriceAtRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPriceAtRequest) ProtoMessage() {}
+
+func (x *GetPriceAtRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPriceAtRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceAtRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetPriceAtRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetPriceAtRequest) GetAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.At
+	}
+	return nil
+}
+
+type PriceChangeMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Price         *Money                 `protobuf:"bytes,3,opt,name=Price,proto3" json:"Price,omitempty"`
+	Status        PriceChangeStatus      `protobuf:"varint,4,opt,name=Status,proto3,enum=iims.PriceChangeStatus" json:"Status,omitempty"`
+	EffectiveFrom *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=EffectiveFrom,proto3" json:"EffectiveFrom,omitempty"`
+	EffectiveTo   *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=EffectiveTo,proto3" json:"EffectiveTo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceChangeMessage) Reset() {
+	*x = PriceChangeMessage{}
+	mi := &file_iims_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceChangeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceChangeMessage) ProtoMessage() {}
+
+func (x *PriceChangeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceChangeMessage.ProtoReflect.Descriptor instead.
+func (*PriceChangeMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PriceChangeMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PriceChangeMessage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceChangeMessage) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+func (x *PriceChangeMessage) GetStatus() PriceChangeStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PriceChangeStatus_PRICE_CHANGE_STATUS_UNSPECIFIED
+}
+
+func (x *PriceChangeMessage) GetEffectiveFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveFrom
+	}
+	return nil
+}
+
+func (x *PriceChangeMessage) GetEffectiveTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EffectiveTo
+	}
+	return nil
+}
+
+type GetProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*GetProductMessage   `protobuf:"bytes,1,rep,name=Products,proto3" json:"Products,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductsResponse) Reset() {
+	*x = GetProductsResponse{}
+	mi := &file_iims_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductsResponse) ProtoMessage() {}
+
+func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductsResponse.ProtoReflect.Descriptor instead.
+func (*GetProductsResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetProductsResponse) GetProducts() []*GetProductMessage {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetProductsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetProductsResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// DeleteProductRequest only marks the product as deleted; it stays restorable until it is purged.
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_iims_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DeleteProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RestoreProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreProductRequest) Reset() {
+	*x = RestoreProductRequest{}
+	mi := &file_iims_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreProductRequest) ProtoMessage() {}
+
+func (x *RestoreProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreProductRequest.ProtoReflect.Descriptor instead.
+func (*RestoreProductRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RestoreProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PurgeProductRequest removes a deleted product and its variants for good. Its stock movements
+// stay in the ledger, with a write-off for whatever was still on hand. The product is dropped
+// from the sales that still refer to it; its bundles, and product sales it was the only target
+// of, are deleted.
+type PurgeProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeProductRequest) Reset() {
+	*x = PurgeProductRequest{}
+	mi := &file_iims_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeProductRequest) ProtoMessage() {}
+
+func (x *PurgeProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeProductRequest.ProtoReflect.Descriptor instead.
+func (*PurgeProductRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *PurgeProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateProductRequest struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name         string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description  string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	CreationDate string                 `protobuf:"bytes,4,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
+	// ProductCode keeps the stored code when it is empty; a product code cannot be removed.
+	ProductCode string `protobuf:"bytes,6,opt,name=ProductCode,proto3" json:"ProductCode,omitempty"`
+	// Barcodes replace the stored ones when any are given; an empty list keeps them.
+	Barcodes   []string `protobuf:"bytes,7,rep,name=Barcodes,proto3" json:"Barcodes,omitempty"`
+	CategoryId string   `protobuf:"bytes,8,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	// Attributes are merged into the stored ones: an omitted attribute keeps its value and an empty
+	// value removes it. Stored values the category no longer defines are dropped.
+	Attributes map[string]string `protobuf:"bytes,9,rep,name=Attributes,proto3" json:"Attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Price must be in the product currency; the currency of a product cannot change.
+	Price         *Money `protobuf:"bytes,10,opt,name=Price,proto3" json:"Price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_iims_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateProductRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetCreationDate() string {
+	if x != nil {
+		return x.CreationDate
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetProductCode() string {
+	if x != nil {
+		return x.ProductCode
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetBarcodes() []string {
+	if x != nil {
+		return x.Barcodes
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetAttributes() map[string]string {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+// BlockProductOperationMessage names who blocks the product and why. Both are ignored on unblock.
+type BlockProductOperationMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockProductOperationMessage) Reset() {
+	*x = BlockProductOperationMessage{}
+	mi := &file_iims_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockProductOperationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockProductOperationMessage) ProtoMessage() {}
+
+func (x *BlockProductOperationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockProductOperationMessage.ProtoReflect.Descriptor instead.
+func (*BlockProductOperationMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *BlockProductOperationMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlockProductOperationMessage) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BlockProductOperationMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetAvailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAvailabilityRequest) Reset() {
+	*x = GetAvailabilityRequest{}
+	mi := &file_iims_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAvailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAvailabilityRequest) ProtoMessage() {}
+
+func (x *GetAvailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAvailabilityRequest.ProtoReflect.Descriptor instead.
+func (*GetAvailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetAvailabilityRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+type WarehouseAvailabilityMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   string                 `protobuf:"bytes,1,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Reserved      int64                  `protobuf:"varint,3,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
+	Available     int64                  `protobuf:"varint,4,opt,name=Available,proto3" json:"Available,omitempty"`
+	InTransit     int64                  `protobuf:"varint,5,opt,name=InTransit,proto3" json:"InTransit,omitempty"`
+	VariantId     string                 `protobuf:"bytes,6,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseAvailabilityMessage) Reset() {
+	*x = WarehouseAvailabilityMessage{}
+	mi := &file_iims_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseAvailabilityMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseAvailabilityMessage) ProtoMessage() {}
+
+func (x *WarehouseAvailabilityMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseAvailabilityMessage.ProtoReflect.Descriptor instead.
+func (*WarehouseAvailabilityMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *WarehouseAvailabilityMessage) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *WarehouseAvailabilityMessage) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WarehouseAvailabilityMessage) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *WarehouseAvailabilityMessage) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *WarehouseAvailabilityMessage) GetInTransit() int64 {
+	if x != nil {
+		return x.InTransit
+	}
+	return 0
+}
+
+func (x *WarehouseAvailabilityMessage) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type ProductAvailabilityMessage struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	ProductId     string                          `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                           `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Reserved      int64                           `protobuf:"varint,3,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
+	Available     int64                           `protobuf:"varint,4,opt,name=Available,proto3" json:"Available,omitempty"`
+	Warehouses    []*WarehouseAvailabilityMessage `protobuf:"bytes,5,rep,name=Warehouses,proto3" json:"Warehouses,omitempty"`
+	InTransit     int64                           `protobuf:"varint,6,opt,name=InTransit,proto3" json:"InTransit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProductAvailabilityMessage) Reset() {
+	*x = ProductAvailabilityMessage{}
+	mi := &file_iims_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProductAvailabilityMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProductAvailabilityMessage) ProtoMessage() {}
+
+func (x *ProductAvailabilityMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProductAvailabilityMessage.ProtoReflect.Descriptor instead.
+func (*ProductAvailabilityMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ProductAvailabilityMessage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ProductAvailabilityMessage) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ProductAvailabilityMessage) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *ProductAvailabilityMessage) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *ProductAvailabilityMessage) GetWarehouses() []*WarehouseAvailabilityMessage {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+func (x *ProductAvailabilityMessage) GetInTransit() int64 {
+	if x != nil {
+		return x.InTransit
+	}
+	return 0
+}
+
+// InsertSaleRequest targets Product and Products when Target is unspecified or PRODUCTS, the
+// subtrees of Categories for CATEGORIES and every product for ALL. ExcludedProducts are left
+// out of category and ALL sales.
+//
+// InsertSaleRequest describes the discount by its DiscountType, percentage when unspecified:
+// SaleSize is the percentage, Amount is taken off a unit for FIXED_AMOUNT, is the unit price
+// for FIXED_PRICE and the price of the whole bundle for BUNDLE. BUY_X_GET_Y gives FreeQuantity
+// units for every BuyQuantity paid for. A bundle is one unit of Product and of each of BundleProducts.
+type InsertSaleRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description      string                 `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	SaleSize         int32                  `protobuf:"varint,3,opt,name=SaleSize,proto3" json:"SaleSize,omitempty"`
+	Product          string                 `protobuf:"bytes,4,opt,name=Product,proto3" json:"Product,omitempty"`
+	StartsAt         *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`
+	EndsAt           *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	DiscountType     DiscountType           `protobuf:"varint,7,opt,name=DiscountType,proto3,enum=iims.DiscountType" json:"DiscountType,omitempty"`
+	Amount           *Money                 `protobuf:"bytes,8,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	BuyQuantity      int64                  `protobuf:"varint,9,opt,name=BuyQuantity,proto3" json:"BuyQuantity,omitempty"`
+	FreeQuantity     int64                  `protobuf:"varint,10,opt,name=FreeQuantity,proto3" json:"FreeQuantity,omitempty"`
+	BundleProducts   []string               `protobuf:"bytes,11,rep,name=BundleProducts,proto3" json:"BundleProducts,omitempty"`
+	Target           SaleTarget             `protobuf:"varint,12,opt,name=Target,proto3,enum=iims.SaleTarget" json:"Target,omitempty"`
+	Products         []string               `protobuf:"bytes,13,rep,name=Products,proto3" json:"Products,omitempty"`
+	Categories       []string               `protobuf:"bytes,14,rep,name=Categories,proto3" json:"Categories,omitempty"`
+	ExcludedProducts []string               `protobuf:"bytes,15,rep,name=ExcludedProducts,proto3" json:"ExcludedProducts,omitempty"`
+	// Variants limits a product sale to these variants of its products. Bundles cannot be
+	// limited to variants.
+	Variants      []string `protobuf:"bytes,16,rep,name=Variants,proto3" json:"Variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertSaleRequest) Reset() {
+	*x = InsertSaleRequest{}
+	mi := &file_iims_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertSaleRequest) ProtoMessage() {}
+
+func (x *InsertSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertSaleRequest.ProtoReflect.Descriptor instead.
+func (*InsertSaleRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *InsertSaleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsertSaleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InsertSaleRequest) GetSaleSize() int32 {
+	if x != nil {
+		return x.SaleSize
+	}
+	return 0
+}
+
+func (x *InsertSaleRequest) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *InsertSaleRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *InsertSaleRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *InsertSaleRequest) GetDiscountType() DiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *InsertSaleRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *InsertSaleRequest) GetBuyQuantity() int64 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *InsertSaleRequest) GetFreeQuantity() int64 {
+	if x != nil {
+		return x.FreeQuantity
+	}
+	return 0
+}
+
+func (x *InsertSaleRequest) GetBundleProducts() []string {
+	if x != nil {
+		return x.BundleProducts
+	}
+	return nil
+}
+
+func (x *InsertSaleRequest) GetTarget() SaleTarget {
+	if x != nil {
+		return x.Target
+	}
+	return SaleTarget_SALE_TARGET_UNSPECIFIED
+}
+
+func (x *InsertSaleRequest) GetProducts() []string {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *InsertSaleRequest) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *InsertSaleRequest) GetExcludedProducts() []string {
+	if x != nil {
+		return x.ExcludedProducts
+	}
+	return nil
+}
+
+func (x *InsertSaleRequest) GetVariants() []string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type InsertSaleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertSaleResponse) Reset() {
+	*x = InsertSaleResponse{}
+	mi := &file_iims_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertSaleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertSaleResponse) ProtoMessage() {}
+
+func (x *InsertSaleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertSaleResponse.ProtoReflect.Descriptor instead.
+func (*InsertSaleResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *InsertSaleResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetSalesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Limit is the page size: 50 when it is zero, and at most 1000.
+	Limit             int64                  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset            int64                  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	PageToken         string                 `protobuf:"bytes,3,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	IncludeTotalCount bool                   `protobuf:"varint,4,opt,name=IncludeTotalCount,proto3" json:"IncludeTotalCount,omitempty"`
+	ActiveAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ActiveAt,proto3" json:"ActiveAt,omitempty"`
+	// ProductId keeps only the sales that apply to the product.
+	ProductId      string `protobuf:"bytes,6,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	IncludeDeleted bool   `protobuf:"varint,7,opt,name=IncludeDeleted,proto3" json:"IncludeDeleted,omitempty"`
+	IncludeBlocked bool   `protobuf:"varint,8,opt,name=IncludeBlocked,proto3" json:"IncludeBlocked,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetSalesRequest) Reset() {
+	*x = GetSalesRequest{}
+	mi := &file_iims_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesRequest) ProtoMessage() {}
+
+func (x *GetSalesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesRequest.ProtoReflect.Descriptor instead.
+func (*GetSalesRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *GetSalesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSalesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *GetSalesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *GetSalesRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *GetSalesRequest) GetActiveAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActiveAt
+	}
+	return nil
+}
+
+func (x *GetSalesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetSalesRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *GetSalesRequest) GetIncludeBlocked() bool {
+	if x != nil {
+		return x.IncludeBlocked
+	}
+	return false
+}
+
+// GetSaleMessage fills Product only for a sale that targets exactly one product.
+type GetSaleMessage struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description      string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	SaleSize         int32                  `protobuf:"varint,4,opt,name=SaleSize,proto3" json:"SaleSize,omitempty"`
+	Product          string                 `protobuf:"bytes,5,opt,name=Product,proto3" json:"Product,omitempty"`
+	StartsAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`
+	EndsAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	Status           SaleStatus             `protobuf:"varint,8,opt,name=Status,proto3,enum=iims.SaleStatus" json:"Status,omitempty"`
+	DiscountType     DiscountType           `protobuf:"varint,9,opt,name=DiscountType,proto3,enum=iims.DiscountType" json:"DiscountType,omitempty"`
+	Amount           *Money                 `protobuf:"bytes,10,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	BuyQuantity      int64                  `protobuf:"varint,11,opt,name=BuyQuantity,proto3" json:"BuyQuantity,omitempty"`
+	FreeQuantity     int64                  `protobuf:"varint,12,opt,name=FreeQuantity,proto3" json:"FreeQuantity,omitempty"`
+	BundleProducts   []string               `protobuf:"bytes,13,rep,name=BundleProducts,proto3" json:"BundleProducts,omitempty"`
+	Target           SaleTarget             `protobuf:"varint,14,opt,name=Target,proto3,enum=iims.SaleTarget" json:"Target,omitempty"`
+	Products         []string               `protobuf:"bytes,15,rep,name=Products,proto3" json:"Products,omitempty"`
+	Categories       []string               `protobuf:"bytes,16,rep,name=Categories,proto3" json:"Categories,omitempty"`
+	ExcludedProducts []string               `protobuf:"bytes,17,rep,name=ExcludedProducts,proto3" json:"ExcludedProducts,omitempty"`
+	// DeletedAt is set only for a deleted sale that has not been purged yet.
+	DeletedAt     *timestamppb.Timestamp `protobuf:"bytes,18,opt,name=DeletedAt,proto3" json:"DeletedAt,omitempty"`
+	Blocked       bool                   `protobuf:"varint,19,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+	BlockedBy     string                 `protobuf:"bytes,20,opt,name=BlockedBy,proto3" json:"BlockedBy,omitempty"`
+	BlockedAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=BlockedAt,proto3" json:"BlockedAt,omitempty"`
+	BlockedReason string                 `protobuf:"bytes,22,opt,name=BlockedReason,proto3" json:"BlockedReason,omitempty"`
+	Variants      []string               `protobuf:"bytes,23,rep,name=Variants,proto3" json:"Variants,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSaleMessage) Reset() {
+	*x = GetSaleMessage{}
+	mi := &file_iims_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSaleMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSaleMessage) ProtoMessage() {}
+
+func (x *GetSaleMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSaleMessage.ProtoReflect.Descriptor instead.
+func (*GetSaleMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetSaleMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetSaleMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetSaleMessage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetSaleMessage) GetSaleSize() int32 {
+	if x != nil {
+		return x.SaleSize
+	}
+	return 0
+}
+
+func (x *GetSaleMessage) GetProduct() string {
+	if x != nil {
+		return x.Product
+	}
+	return ""
+}
+
+func (x *GetSaleMessage) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetStatus() SaleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return SaleStatus_SALE_STATUS_UNSPECIFIED
+}
+
+func (x *GetSaleMessage) GetDiscountType() DiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *GetSaleMessage) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetBuyQuantity() int64 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *GetSaleMessage) GetFreeQuantity() int64 {
+	if x != nil {
+		return x.FreeQuantity
+	}
+	return 0
+}
+
+func (x *GetSaleMessage) GetBundleProducts() []string {
+	if x != nil {
+		return x.BundleProducts
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetTarget() SaleTarget {
+	if x != nil {
+		return x.Target
+	}
+	return SaleTarget_SALE_TARGET_UNSPECIFIED
+}
+
+func (x *GetSaleMessage) GetProducts() []string {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetCategories() []string {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetExcludedProducts() []string {
+	if x != nil {
+		return x.ExcludedProducts
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetDeletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *GetSaleMessage) GetBlockedBy() string {
+	if x != nil {
+		return x.BlockedBy
+	}
+	return ""
+}
+
+func (x *GetSaleMessage) GetBlockedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.BlockedAt
+	}
+	return nil
+}
+
+func (x *GetSaleMessage) GetBlockedReason() string {
+	if x != nil {
+		return x.BlockedReason
+	}
+	return ""
+}
+
+func (x *GetSaleMessage) GetVariants() []string {
+	if x != nil {
+		return x.Variants
+	}
+	return nil
+}
+
+type GetSalesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sales         []*GetSaleMessage      `protobuf:"bytes,1,rep,name=Sales,proto3" json:"Sales,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=NextPageToken,proto3" json:"NextPageToken,omitempty"`
+	TotalCount    int64                  `protobuf:"varint,3,opt,name=TotalCount,proto3" json:"TotalCount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSalesResponse) Reset() {
+	*x = GetSalesResponse{}
+	mi := &file_iims_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSalesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSalesResponse) ProtoMessage() {}
+
+func (x *GetSalesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSalesResponse.ProtoReflect.Descriptor instead.
+func (*GetSalesResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetSalesResponse) GetSales() []*GetSaleMessage {
+	if x != nil {
+		return x.Sales
+	}
+	return nil
+}
+
+func (x *GetSalesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *GetSalesResponse) GetTotalCount() int64 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+// ListSalesForProductRequest lists the sales that name the product as a target or in a bundle.
+// Unlike GetSalesRequest.ProductId it leaves out category and catalogue-wide sales.
+type ListSalesForProductRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	// Limit is the page size: 50 when it is zero, and at most 1000.
+	Limit             int64  `protobuf:"varint,2,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset            int64  `protobuf:"varint,3,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	PageToken         string `protobuf:"bytes,4,opt,name=PageToken,proto3" json:"PageToken,omitempty"`
+	IncludeTotalCount bool   `protobuf:"varint,5,opt,name=IncludeTotalCount,proto3" json:"IncludeTotalCount,omitempty"`
+	IncludeDeleted    bool   `protobuf:"varint,6,opt,name=IncludeDeleted,proto3" json:"IncludeDeleted,omitempty"`
+	IncludeBlocked    bool   `protobuf:"varint,7,opt,name=IncludeBlocked,proto3" json:"IncludeBlocked,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ListSalesForProductRequest) Reset() {
+	*x = ListSalesForProductRequest{}
+	mi := &file_iims_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSalesForProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSalesForProductRequest) ProtoMessage() {}
+
+func (x *ListSalesForProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSalesForProductRequest.ProtoReflect.Descriptor instead.
+func (*ListSalesForProductRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListSalesForProductRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListSalesForProductRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListSalesForProductRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListSalesForProductRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+func (x *ListSalesForProductRequest) GetIncludeTotalCount() bool {
+	if x != nil {
+		return x.IncludeTotalCount
+	}
+	return false
+}
+
+func (x *ListSalesForProductRequest) GetIncludeDeleted() bool {
+	if x != nil {
+		return x.IncludeDeleted
+	}
+	return false
+}
+
+func (x *ListSalesForProductRequest) GetIncludeBlocked() bool {
+	if x != nil {
+		return x.IncludeBlocked
+	}
+	return false
+}
+
+// DeleteSaleRequest only marks the sale as deleted; it stays restorable until it is purged.
+type DeleteSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteSaleRequest) Reset() {
+	*x = DeleteSaleRequest{}
+	mi := &file_iims_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSaleRequest) ProtoMessage() {}
+
+func (x *DeleteSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSaleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSaleRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DeleteSaleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// RestoreSaleRequest brings a deleted sale back, unless a product it targets is gone.
+type RestoreSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RestoreSaleRequest) Reset() {
+	*x = RestoreSaleRequest{}
+	mi := &file_iims_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RestoreSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreSaleRequest) ProtoMessage() {}
+
+func (x *RestoreSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreSaleRequest.ProtoReflect.Descriptor instead.
+func (*RestoreSaleRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RestoreSaleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// PurgeSaleRequest removes a deleted sale for good.
+type PurgeSaleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PurgeSaleRequest) Reset() {
+	*x = PurgeSaleRequest{}
+	mi := &file_iims_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PurgeSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurgeSaleRequest) ProtoMessage() {}
+
+func (x *PurgeSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurgeSaleRequest.ProtoReflect.Descriptor instead.
+func (*PurgeSaleRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *PurgeSaleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// UpdateSaleRequest replaces the sale window, a bound left empty is open, and the discount,
+// which is described as in InsertSaleRequest.
+type UpdateSaleRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description    string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	SaleSize       int32                  `protobuf:"varint,4,opt,name=SaleSize,proto3" json:"SaleSize,omitempty"`
+	StartsAt       *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=StartsAt,proto3" json:"StartsAt,omitempty"`
+	EndsAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=EndsAt,proto3" json:"EndsAt,omitempty"`
+	DiscountType   DiscountType           `protobuf:"varint,7,opt,name=DiscountType,proto3,enum=iims.DiscountType" json:"DiscountType,omitempty"`
+	Amount         *Money                 `protobuf:"bytes,8,opt,name=Amount,proto3" json:"Amount,omitempty"`
+	BuyQuantity    int64                  `protobuf:"varint,9,opt,name=BuyQuantity,proto3" json:"BuyQuantity,omitempty"`
+	FreeQuantity   int64                  `protobuf:"varint,10,opt,name=FreeQuantity,proto3" json:"FreeQuantity,omitempty"`
+	BundleProducts []string               `protobuf:"bytes,11,rep,name=BundleProducts,proto3" json:"BundleProducts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateSaleRequest) Reset() {
+	*x = UpdateSaleRequest{}
+	mi := &file_iims_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSaleRequest) ProtoMessage() {}
+
+func (x *UpdateSaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSaleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateSaleRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateSaleRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateSaleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateSaleRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateSaleRequest) GetSaleSize() int32 {
+	if x != nil {
+		return x.SaleSize
+	}
+	return 0
+}
+
+func (x *UpdateSaleRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *UpdateSaleRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *UpdateSaleRequest) GetDiscountType() DiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+func (x *UpdateSaleRequest) GetAmount() *Money {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+func (x *UpdateSaleRequest) GetBuyQuantity() int64 {
+	if x != nil {
+		return x.BuyQuantity
+	}
+	return 0
+}
+
+func (x *UpdateSaleRequest) GetFreeQuantity() int64 {
+	if x != nil {
+		return x.FreeQuantity
+	}
+	return 0
+}
+
+func (x *UpdateSaleRequest) GetBundleProducts() []string {
+	if x != nil {
+		return x.BundleProducts
+	}
+	return nil
+}
+
+// BlockSaleOperationMessage names who blocks the sale and why. Both are ignored on unblock.
+type BlockSaleOperationMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=Reason,proto3" json:"Reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockSaleOperationMessage) Reset() {
+	*x = BlockSaleOperationMessage{}
+	mi := &file_iims_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockSaleOperationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockSaleOperationMessage) ProtoMessage() {}
+
+func (x *BlockSaleOperationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockSaleOperationMessage.ProtoReflect.Descriptor instead.
+func (*BlockSaleOperationMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *BlockSaleOperationMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *BlockSaleOperationMessage) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *BlockSaleOperationMessage) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// PriceLineRequest prices Quantity units of the product, or of its variant when VariantId is
+// set. A variant line starts from the variant price.
+type PriceLineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceLineRequest) Reset() {
+	*x = PriceLineRequest{}
+	mi := &file_iims_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceLineRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceLineRequest) ProtoMessage() {}
+
+func (x *PriceLineRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceLineRequest.ProtoReflect.Descriptor instead.
+func (*PriceLineRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PriceLineRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PriceLineRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PriceLineRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+// CalculatePriceRequest prices either one unit of ProductId, or of its VariantId, or the basket
+// in Lines. Policy overrides the configured way of combining sales when set. Sales are taken off
+// the base price of the product or variant; channel price lists are not applied, use
+// PriceListService.ResolvePrice for a channel price.
+type CalculatePriceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Lines         []*PriceLineRequest    `protobuf:"bytes,2,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	Policy        SaleCombinePolicy      `protobuf:"varint,3,opt,name=Policy,proto3,enum=iims.SaleCombinePolicy" json:"Policy,omitempty"`
+	VariantId     string                 `protobuf:"bytes,4,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculatePriceRequest) Reset() {
+	*x = CalculatePriceRequest{}
+	mi := &file_iims_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePriceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePriceRequest) ProtoMessage() {}
+
+func (x *CalculatePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePriceRequest.ProtoReflect.Descriptor instead.
+func (*CalculatePriceRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *CalculatePriceRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CalculatePriceRequest) GetLines() []*PriceLineRequest {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CalculatePriceRequest) GetPolicy() SaleCombinePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return SaleCombinePolicy_SALE_COMBINE_POLICY_UNSPECIFIED
+}
+
+func (x *CalculatePriceRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+// AppliedSaleMessage shows the amount a sale took off the whole line.
+type AppliedSaleMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SaleId        string                 `protobuf:"bytes,1,opt,name=SaleId,proto3" json:"SaleId,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	SaleSize      int32                  `protobuf:"varint,3,opt,name=SaleSize,proto3" json:"SaleSize,omitempty"`
+	Discount      *Money                 `protobuf:"bytes,4,opt,name=Discount,proto3" json:"Discount,omitempty"`
+	DiscountType  DiscountType           `protobuf:"varint,5,opt,name=DiscountType,proto3,enum=iims.DiscountType" json:"DiscountType,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AppliedSaleMessage) Reset() {
+	*x = AppliedSaleMessage{}
+	mi := &file_iims_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AppliedSaleMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AppliedSaleMessage) ProtoMessage() {}
+
+func (x *AppliedSaleMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AppliedSaleMessage.ProtoReflect.Descriptor instead.
+func (*AppliedSaleMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *AppliedSaleMessage) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+func (x *AppliedSaleMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AppliedSaleMessage) GetSaleSize() int32 {
+	if x != nil {
+		return x.SaleSize
+	}
+	return 0
+}
+
+func (x *AppliedSaleMessage) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *AppliedSaleMessage) GetDiscountType() DiscountType {
+	if x != nil {
+		return x.DiscountType
+	}
+	return DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+// PricedLineMessage shows how a line total was reached. Discount is the amount taken off the
+// line; LineTotal is BasePrice times Quantity less Discount. A bundle discount is spread over the
+// lines of the bundle products in proportion to their price, and it applies to all of them or,
+// when the combine policy picks another sale on any of them, to none.
+type PricedLineMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	BasePrice     *Money                 `protobuf:"bytes,3,opt,name=BasePrice,proto3" json:"BasePrice,omitempty"`
+	Sales         []*AppliedSaleMessage  `protobuf:"bytes,4,rep,name=Sales,proto3" json:"Sales,omitempty"`
+	Discount      *Money                 `protobuf:"bytes,5,opt,name=Discount,proto3" json:"Discount,omitempty"`
+	LineTotal     *Money                 `protobuf:"bytes,7,opt,name=LineTotal,proto3" json:"LineTotal,omitempty"`
+	VariantId     string                 `protobuf:"bytes,8,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PricedLineMessage) Reset() {
+	*x = PricedLineMessage{}
+	mi := &file_iims_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PricedLineMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PricedLineMessage) ProtoMessage() {}
+
+func (x *PricedLineMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PricedLineMessage.ProtoReflect.Descriptor instead.
+func (*PricedLineMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PricedLineMessage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *PricedLineMessage) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *PricedLineMessage) GetBasePrice() *Money {
+	if x != nil {
+		return x.BasePrice
+	}
+	return nil
+}
+
+func (x *PricedLineMessage) GetSales() []*AppliedSaleMessage {
+	if x != nil {
+		return x.Sales
+	}
+	return nil
+}
+
+func (x *PricedLineMessage) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
+}
+
+func (x *PricedLineMessage) GetLineTotal() *Money {
+	if x != nil {
+		return x.LineTotal
+	}
+	return nil
+}
+
+func (x *PricedLineMessage) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type CalculatePriceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lines         []*PricedLineMessage   `protobuf:"bytes,1,rep,name=Lines,proto3" json:"Lines,omitempty"`
+	Total         *Money                 `protobuf:"bytes,2,opt,name=Total,proto3" json:"Total,omitempty"`
+	Policy        SaleCombinePolicy      `protobuf:"varint,3,opt,name=Policy,proto3,enum=iims.SaleCombinePolicy" json:"Policy,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CalculatePriceResponse) Reset() {
+	*x = CalculatePriceResponse{}
+	mi := &file_iims_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CalculatePriceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CalculatePriceResponse) ProtoMessage() {}
+
+func (x *CalculatePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CalculatePriceResponse.ProtoReflect.Descriptor instead.
+func (*CalculatePriceResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CalculatePriceResponse) GetLines() []*PricedLineMessage {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CalculatePriceResponse) GetTotal() *Money {
+	if x != nil {
+		return x.Total
+	}
+	return nil
+}
+
+func (x *CalculatePriceResponse) GetPolicy() SaleCombinePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return SaleCombinePolicy_SALE_COMBINE_POLICY_UNSPECIFIED
+}
+
+type GetStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,2,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	VariantId     string                 `protobuf:"bytes,3,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStockRequest) Reset() {
+	*x = GetStockRequest{}
+	mi := &file_iims_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStockRequest) ProtoMessage() {}
+
+func (x *GetStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStockRequest.ProtoReflect.Descriptor instead.
+func (*GetStockRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *GetStockRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *GetStockRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type AdjustStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Delta         int64                  `protobuf:"varint,2,opt,name=Delta,proto3" json:"Delta,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,3,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	Reason        MovementReason         `protobuf:"varint,4,opt,name=Reason,proto3,enum=iims.MovementReason" json:"Reason,omitempty"`
+	Actor         string                 `protobuf:"bytes,5,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	VariantId     string                 `protobuf:"bytes,6,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdjustStockRequest) Reset() {
+	*x = AdjustStockRequest{}
+	mi := &file_iims_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdjustStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdjustStockRequest) ProtoMessage() {}
+
+func (x *AdjustStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdjustStockRequest.ProtoReflect.Descriptor instead.
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *AdjustStockRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *AdjustStockRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetReason() MovementReason {
+	if x != nil {
+		return x.Reason
+	}
+	return MovementReason_MOVEMENT_REASON_UNSPECIFIED
+}
+
+func (x *AdjustStockRequest) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *AdjustStockRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type StockReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,3,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	VariantId     string                 `protobuf:"bytes,4,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockReservationRequest) Reset() {
+	*x = StockReservationRequest{}
+	mi := &file_iims_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockReservationRequest) ProtoMessage() {}
+
+func (x *StockReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockReservationRequest.ProtoReflect.Descriptor instead.
+func (*StockReservationRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StockReservationRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockReservationRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockReservationRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *StockReservationRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type StockMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Quantity      int64                  `protobuf:"varint,2,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Reserved      int64                  `protobuf:"varint,3,opt,name=Reserved,proto3" json:"Reserved,omitempty"`
+	Available     int64                  `protobuf:"varint,4,opt,name=Available,proto3" json:"Available,omitempty"`
+	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=UpdatedAt,proto3" json:"UpdatedAt,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,6,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	InTransit     int64                  `protobuf:"varint,7,opt,name=InTransit,proto3" json:"InTransit,omitempty"`
+	VariantId     string                 `protobuf:"bytes,8,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMessage) Reset() {
+	*x = StockMessage{}
+	mi := &file_iims_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMessage) ProtoMessage() {}
+
+func (x *StockMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMessage.ProtoReflect.Descriptor instead.
+func (*StockMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StockMessage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockMessage) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockMessage) GetReserved() int64 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *StockMessage) GetAvailable() int64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *StockMessage) GetUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *StockMessage) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *StockMessage) GetInTransit() int64 {
+	if x != nil {
+		return x.InTransit
+	}
+	return 0
+}
+
+func (x *StockMessage) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type ListMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,2,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=From,proto3" json:"From,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=To,proto3" json:"To,omitempty"`
+	Limit         int64                  `protobuf:"varint,5,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,6,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	VariantId     string                 `protobuf:"bytes,7,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMovementsRequest) Reset() {
+	*x = ListMovementsRequest{}
+	mi := &file_iims_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMovementsRequest) ProtoMessage() {}
+
+func (x *ListMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ListMovementsRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *ListMovementsRequest) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *ListMovementsRequest) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *ListMovementsRequest) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *ListMovementsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListMovementsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *ListMovementsRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type StockMovementMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,3,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	Reason        MovementReason         `protobuf:"varint,4,opt,name=Reason,proto3,enum=iims.MovementReason" json:"Reason,omitempty"`
+	Delta         int64                  `protobuf:"varint,5,opt,name=Delta,proto3" json:"Delta,omitempty"`
+	Actor         string                 `protobuf:"bytes,6,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	TransferId    string                 `protobuf:"bytes,7,opt,name=TransferId,proto3" json:"TransferId,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	VariantId     string                 `protobuf:"bytes,9,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovementMessage) Reset() {
+	*x = StockMovementMessage{}
+	mi := &file_iims_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovementMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovementMessage) ProtoMessage() {}
+
+func (x *StockMovementMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovementMessage.ProtoReflect.Descriptor instead.
+func (*StockMovementMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *StockMovementMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *StockMovementMessage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *StockMovementMessage) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *StockMovementMessage) GetReason() MovementReason {
+	if x != nil {
+		return x.Reason
+	}
+	return MovementReason_MOVEMENT_REASON_UNSPECIFIED
+}
+
+func (x *StockMovementMessage) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovementMessage) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+func (x *StockMovementMessage) GetTransferId() string {
+	if x != nil {
+		return x.TransferId
+	}
+	return ""
+}
+
+func (x *StockMovementMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *StockMovementMessage) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type ListMovementsResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Movements     []*StockMovementMessage `protobuf:"bytes,1,rep,name=Movements,proto3" json:"Movements,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMovementsResponse) Reset() {
+	*x = ListMovementsResponse{}
+	mi := &file_iims_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMovementsResponse) ProtoMessage() {}
+
+func (x *ListMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ListMovementsResponse) GetMovements() []*StockMovementMessage {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+// RebuildBalancesRequest rebuilds the balances of one product, or of every product when ProductId
+// is empty. Each product is rebuilt in its own transaction.
+type RebuildBalancesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=DryRun,proto3" json:"DryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildBalancesRequest) Reset() {
+	*x = RebuildBalancesRequest{}
+	mi := &file_iims_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildBalancesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildBalancesRequest) ProtoMessage() {}
+
+func (x *RebuildBalancesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildBalancesRequest.ProtoReflect.Descriptor instead.
+func (*RebuildBalancesRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RebuildBalancesRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *RebuildBalancesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type BalanceDriftMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	WarehouseId   string                 `protobuf:"bytes,2,opt,name=WarehouseId,proto3" json:"WarehouseId,omitempty"`
+	Recorded      int64                  `protobuf:"varint,3,opt,name=Recorded,proto3" json:"Recorded,omitempty"`
+	Rebuilt       int64                  `protobuf:"varint,4,opt,name=Rebuilt,proto3" json:"Rebuilt,omitempty"`
+	VariantId     string                 `protobuf:"bytes,5,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceDriftMessage) Reset() {
+	*x = BalanceDriftMessage{}
+	mi := &file_iims_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceDriftMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceDriftMessage) ProtoMessage() {}
+
+func (x *BalanceDriftMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceDriftMessage.ProtoReflect.Descriptor instead.
+func (*BalanceDriftMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *BalanceDriftMessage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *BalanceDriftMessage) GetWarehouseId() string {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return ""
+}
+
+func (x *BalanceDriftMessage) GetRecorded() int64 {
+	if x != nil {
+		return x.Recorded
+	}
+	return 0
+}
+
+func (x *BalanceDriftMessage) GetRebuilt() int64 {
+	if x != nil {
+		return x.Rebuilt
+	}
+	return 0
+}
+
+func (x *BalanceDriftMessage) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type RebuildBalancesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drifts        []*BalanceDriftMessage `protobuf:"bytes,1,rep,name=Drifts,proto3" json:"Drifts,omitempty"`
+	Applied       bool                   `protobuf:"varint,2,opt,name=Applied,proto3" json:"Applied,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebuildBalancesResponse) Reset() {
+	*x = RebuildBalancesResponse{}
+	mi := &file_iims_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebuildBalancesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebuildBalancesResponse) ProtoMessage() {}
+
+func (x *RebuildBalancesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebuildBalancesResponse.ProtoReflect.Descriptor instead.
+func (*RebuildBalancesResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RebuildBalancesResponse) GetDrifts() []*BalanceDriftMessage {
+	if x != nil {
+		return x.Drifts
+	}
+	return nil
+}
+
+func (x *RebuildBalancesResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+type InsertWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=Address,proto3" json:"Address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertWarehouseRequest) Reset() {
+	*x = InsertWarehouseRequest{}
+	mi := &file_iims_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertWarehouseRequest) ProtoMessage() {}
+
+func (x *InsertWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*InsertWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *InsertWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsertWarehouseRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type InsertWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertWarehouseResponse) Reset() {
+	*x = InsertWarehouseResponse{}
+	mi := &file_iims_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertWarehouseResponse) ProtoMessage() {}
+
+func (x *InsertWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*InsertWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *InsertWarehouseResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWarehousesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehousesRequest) Reset() {
+	*x = GetWarehousesRequest{}
+	mi := &file_iims_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehousesRequest) ProtoMessage() {}
+
+func (x *GetWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*GetWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetWarehousesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetWarehousesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetByIdWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdWarehouseRequest) Reset() {
+	*x = GetByIdWarehouseRequest{}
+	mi := &file_iims_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdWarehouseRequest) ProtoMessage() {}
+
+func (x *GetByIdWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetByIdWarehouseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetWarehouseMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	Blocked       bool                   `protobuf:"varint,4,opt,name=Blocked,proto3" json:"Blocked,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseMessage) Reset() {
+	*x = GetWarehouseMessage{}
+	mi := &file_iims_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseMessage) ProtoMessage() {}
+
+func (x *GetWarehouseMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseMessage.ProtoReflect.Descriptor instead.
+func (*GetWarehouseMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetWarehouseMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetWarehouseMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetWarehouseMessage) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *GetWarehouseMessage) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+type GetWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*GetWarehouseMessage `protobuf:"bytes,1,rep,name=Warehouses,proto3" json:"Warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehousesResponse) Reset() {
+	*x = GetWarehousesResponse{}
+	mi := &file_iims_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehousesResponse) ProtoMessage() {}
+
+func (x *GetWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*GetWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetWarehousesResponse) GetWarehouses() []*GetWarehouseMessage {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+type DeleteWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWarehouseRequest) Reset() {
+	*x = DeleteWarehouseRequest{}
+	mi := &file_iims_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWarehouseRequest) ProtoMessage() {}
+
+func (x *DeleteWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *DeleteWarehouseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=Address,proto3" json:"Address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseRequest) Reset() {
+	*x = UpdateWarehouseRequest{}
+	mi := &file_iims_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseRequest) ProtoMessage() {}
+
+func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *UpdateWarehouseRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type BlockWarehouseOperationMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockWarehouseOperationMessage) Reset() {
+	*x = BlockWarehouseOperationMessage{}
+	mi := &file_iims_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockWarehouseOperationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockWarehouseOperationMessage) ProtoMessage() {}
+
+func (x *BlockWarehouseOperationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockWarehouseOperationMessage.ProtoReflect.Descriptor instead.
+func (*BlockWarehouseOperationMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *BlockWarehouseOperationMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CreateTransferRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	FromWarehouseId string                 `protobuf:"bytes,2,opt,name=FromWarehouseId,proto3" json:"FromWarehouseId,omitempty"`
+	ToWarehouseId   string                 `protobuf:"bytes,3,opt,name=ToWarehouseId,proto3" json:"ToWarehouseId,omitempty"`
+	Quantity        int64                  `protobuf:"varint,4,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	VariantId       string                 `protobuf:"bytes,5,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateTransferRequest) Reset() {
+	*x = CreateTransferRequest{}
+	mi := &file_iims_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferRequest) ProtoMessage() {}
+
+func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateTransferRequest) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetFromWarehouseId() string {
+	if x != nil {
+		return x.FromWarehouseId
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetToWarehouseId() string {
+	if x != nil {
+		return x.ToWarehouseId
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type TransferOperationMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Actor         string                 `protobuf:"bytes,2,opt,name=Actor,proto3" json:"Actor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferOperationMessage) Reset() {
+	*x = TransferOperationMessage{}
+	mi := &file_iims_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferOperationMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferOperationMessage) ProtoMessage() {}
+
+func (x *TransferOperationMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferOperationMessage.ProtoReflect.Descriptor instead.
+func (*TransferOperationMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *TransferOperationMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransferOperationMessage) GetActor() string {
+	if x != nil {
+		return x.Actor
+	}
+	return ""
+}
+
+type TransferMessage struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId       string                 `protobuf:"bytes,2,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	FromWarehouseId string                 `protobuf:"bytes,3,opt,name=FromWarehouseId,proto3" json:"FromWarehouseId,omitempty"`
+	ToWarehouseId   string                 `protobuf:"bytes,4,opt,name=ToWarehouseId,proto3" json:"ToWarehouseId,omitempty"`
+	Quantity        int64                  `protobuf:"varint,5,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
+	Status          TransferStatus         `protobuf:"varint,6,opt,name=Status,proto3,enum=iims.TransferStatus" json:"Status,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=CreatedAt,proto3" json:"CreatedAt,omitempty"`
+	ShippedAt       *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=ShippedAt,proto3" json:"ShippedAt,omitempty"`
+	ReceivedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=ReceivedAt,proto3" json:"ReceivedAt,omitempty"`
+	VariantId       string                 `protobuf:"bytes,10,opt,name=VariantId,proto3" json:"VariantId,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *TransferMessage) Reset() {
+	*x = TransferMessage{}
+	mi := &file_iims_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferMessage) ProtoMessage() {}
+
+func (x *TransferMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferMessage.ProtoReflect.Descriptor instead.
+func (*TransferMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *TransferMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TransferMessage) GetProductId() string {
+	if x != nil {
+		return x.ProductId
+	}
+	return ""
+}
+
+func (x *TransferMessage) GetFromWarehouseId() string {
+	if x != nil {
+		return x.FromWarehouseId
+	}
+	return ""
+}
+
+func (x *TransferMessage) GetToWarehouseId() string {
+	if x != nil {
+		return x.ToWarehouseId
+	}
+	return ""
+}
+
+func (x *TransferMessage) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *TransferMessage) GetStatus() TransferStatus {
+	if x != nil {
+		return x.Status
+	}
+	return TransferStatus_TRANSFER_STATUS_UNSPECIFIED
+}
+
+func (x *TransferMessage) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *TransferMessage) GetShippedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ShippedAt
+	}
+	return nil
+}
+
+func (x *TransferMessage) GetReceivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return nil
+}
+
+func (x *TransferMessage) GetVariantId() string {
+	if x != nil {
+		return x.VariantId
+	}
+	return ""
+}
+
+type InsertCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
+	ParentId      string                 `protobuf:"bytes,3,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertCategoryRequest) Reset() {
+	*x = InsertCategoryRequest{}
+	mi := &file_iims_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertCategoryRequest) ProtoMessage() {}
+
+func (x *InsertCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertCategoryRequest.ProtoReflect.Descriptor instead.
+func (*InsertCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *InsertCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsertCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *InsertCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type InsertCategoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertCategoryResponse) Reset() {
+	*x = InsertCategoryResponse{}
+	mi := &file_iims_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertCategoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertCategoryResponse) ProtoMessage() {}
+
+func (x *InsertCategoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertCategoryResponse.ProtoReflect.Descriptor instead.
+func (*InsertCategoryResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *InsertCategoryResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCategoriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesRequest) Reset() {
+	*x = GetCategoriesRequest{}
+	mi := &file_iims_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesRequest) ProtoMessage() {}
+
+func (x *GetCategoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoriesRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetCategoriesRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetCategoriesRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+type GetByIdCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetByIdCategoryRequest) Reset() {
+	*x = GetByIdCategoryRequest{}
+	mi := &file_iims_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetByIdCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetByIdCategoryRequest) ProtoMessage() {}
+
+func (x *GetByIdCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetByIdCategoryRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetByIdCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetCategoryMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	ParentId      string                 `protobuf:"bytes,4,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
+	Ancestors     []string               `protobuf:"bytes,5,rep,name=Ancestors,proto3" json:"Ancestors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryMessage) Reset() {
+	*x = GetCategoryMessage{}
+	mi := &file_iims_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryMessage) ProtoMessage() {}
+
+func (x *GetCategoryMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryMessage.ProtoReflect.Descriptor instead.
+func (*GetCategoryMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetCategoryMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetCategoryMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetCategoryMessage) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *GetCategoryMessage) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *GetCategoryMessage) GetAncestors() []string {
+	if x != nil {
+		return x.Ancestors
+	}
+	return nil
+}
+
+type GetCategoriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Categories    []*GetCategoryMessage  `protobuf:"bytes,1,rep,name=Categories,proto3" json:"Categories,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoriesResponse) Reset() {
+	*x = GetCategoriesResponse{}
+	mi := &file_iims_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoriesResponse) ProtoMessage() {}
+
+func (x *GetCategoriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoriesResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoriesResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetCategoriesResponse) GetCategories() []*GetCategoryMessage {
+	if x != nil {
+		return x.Categories
+	}
+	return nil
+}
+
+type DeleteCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteCategoryRequest) Reset() {
+	*x = DeleteCategoryRequest{}
+	mi := &file_iims_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteCategoryRequest) ProtoMessage() {}
+
+func (x *DeleteCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteCategoryRequest.ProtoReflect.Descriptor instead.
+func (*DeleteCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DeleteCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type UpdateCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCategoryRequest) Reset() {
+	*x = UpdateCategoryRequest{}
+	mi := &file_iims_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCategoryRequest) ProtoMessage() {}
+
+func (x *UpdateCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCategoryRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateCategoryRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type GetCategoryTreeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RootId        string                 `protobuf:"bytes,1,opt,name=RootId,proto3" json:"RootId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeRequest) Reset() {
+	*x = GetCategoryTreeRequest{}
+	mi := &file_iims_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeRequest) ProtoMessage() {}
+
+func (x *GetCategoryTreeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeRequest.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *GetCategoryTreeRequest) GetRootId() string {
+	if x != nil {
+		return x.RootId
+	}
+	return ""
+}
+
+type CategoryTreeNode struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Category      *GetCategoryMessage    `protobuf:"bytes,1,opt,name=Category,proto3" json:"Category,omitempty"`
+	Children      []*CategoryTreeNode    `protobuf:"bytes,2,rep,name=Children,proto3" json:"Children,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CategoryTreeNode) Reset() {
+	*x = CategoryTreeNode{}
+	mi := &file_iims_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CategoryTreeNode) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CategoryTreeNode) ProtoMessage() {}
+
+func (x *CategoryTreeNode) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CategoryTreeNode.ProtoReflect.Descriptor instead.
+func (*CategoryTreeNode) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CategoryTreeNode) GetCategory() *GetCategoryMessage {
+	if x != nil {
+		return x.Category
+	}
+	return nil
+}
+
+func (x *CategoryTreeNode) GetChildren() []*CategoryTreeNode {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+type GetCategoryTreeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roots         []*CategoryTreeNode    `protobuf:"bytes,1,rep,name=Roots,proto3" json:"Roots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCategoryTreeResponse) Reset() {
+	*x = GetCategoryTreeResponse{}
+	mi := &file_iims_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCategoryTreeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCategoryTreeResponse) ProtoMessage() {}
+
+func (x *GetCategoryTreeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCategoryTreeResponse.ProtoReflect.Descriptor instead.
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetCategoryTreeResponse) GetRoots() []*CategoryTreeNode {
+	if x != nil {
+		return x.Roots
+	}
+	return nil
+}
+
+type MoveCategoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ParentId      string                 `protobuf:"bytes,2,opt,name=ParentId,proto3" json:"ParentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveCategoryRequest) Reset() {
+	*x = MoveCategoryRequest{}
+	mi := &file_iims_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveCategoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveCategoryRequest) ProtoMessage() {}
+
+func (x *MoveCategoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveCategoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *MoveCategoryRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MoveCategoryRequest) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+type InsertAttributeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// CategoryId is required and must name an existing category.
+	CategoryId    string        `protobuf:"bytes,1,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	Name          string        `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type          AttributeType `protobuf:"varint,3,opt,name=Type,proto3,enum=iims.AttributeType" json:"Type,omitempty"`
+	Required      bool          `protobuf:"varint,4,opt,name=Required,proto3" json:"Required,omitempty"`
+	AllowedValues []string      `protobuf:"bytes,5,rep,name=AllowedValues,proto3" json:"AllowedValues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertAttributeRequest) Reset() {
+	*x = InsertAttributeRequest{}
+	mi := &file_iims_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertAttributeRequest) ProtoMessage() {}
+
+func (x *InsertAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertAttributeRequest.ProtoReflect.Descriptor instead.
+func (*InsertAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *InsertAttributeRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *InsertAttributeRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsertAttributeRequest) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *InsertAttributeRequest) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *InsertAttributeRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+type InsertAttributeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertAttributeResponse) Reset() {
+	*x = InsertAttributeResponse{}
+	mi := &file_iims_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertAttributeResponse) ProtoMessage() {}
+
+func (x *InsertAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertAttributeResponse.ProtoReflect.Descriptor instead.
+func (*InsertAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *InsertAttributeResponse) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type GetAttributesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CategoryId    string                 `protobuf:"bytes,1,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttributesRequest) Reset() {
+	*x = GetAttributesRequest{}
+	mi := &file_iims_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributesRequest) ProtoMessage() {}
+
+func (x *GetAttributesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributesRequest.ProtoReflect.Descriptor instead.
+func (*GetAttributesRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetAttributesRequest) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+type GetAttributeMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	CategoryId    string                 `protobuf:"bytes,2,opt,name=CategoryId,proto3" json:"CategoryId,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=Name,proto3" json:"Name,omitempty"`
+	Type          AttributeType          `protobuf:"varint,4,opt,name=Type,proto3,enum=iims.AttributeType" json:"Type,omitempty"`
+	Required      bool                   `protobuf:"varint,5,opt,name=Required,proto3" json:"Required,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,6,rep,name=AllowedValues,proto3" json:"AllowedValues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAttributeMessage) Reset() {
+	*x = GetAttributeMessage{}
+	mi := &file_iims_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAttributeMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAttributeMessage) ProtoMessage() {}
+
+func (x *GetAttributeMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAttributeMessage.ProtoReflect.Descriptor instead.
+func (*GetAttributeMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *GetAttributeMessage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *GetAttributeMessage) GetCategoryId() string {
+	if x != nil {
+		return x.CategoryId
+	}
+	return ""
+}
+
+func (x *GetAttributeMessage) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GetAttributeMessage) GetType() AttributeType {
+	if x != nil {
+		return x.Type
+	}
+	return AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func (x *GetAttributeMessage) GetRequired() bool {
+	if x != nil {
+		return x.Required
+	}
+	return false
+}
+
+func (x *GetAttributeMessage) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+type GetAttributesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Attributes    []*GetAttributeMessage `protobuf:"bytes,1,rep,name=Attributes,proto3" json:"Attributes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertProductRequest) Reset() {
-	*x = InsertProductRequest{}
-	mi := &file_iims_proto_msgTypes[0]
+func (x *GetAttributesResponse) Reset() {
+	*x = GetAttributesResponse{}
+	mi := &file_iims_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertProductRequest) String() string {
+func (x *GetAttributesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertProductRequest) ProtoMessage() {}
+func (*GetAttributesResponse) ProtoMessage() {}
 
-func (x *InsertProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[0]
+func (x *GetAttributesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -57,61 +6337,197 @@ func (x *InsertProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertProductRequest.ProtoReflect.Descriptor instead.
-func (*InsertProductRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetAttributesResponse.ProtoReflect.Descriptor instead.
+func (*GetAttributesResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{82}
 }
 
-func (x *InsertProductRequest) GetName() string {
+func (x *GetAttributesResponse) GetAttributes() []*GetAttributeMessage {
 	if x != nil {
-		return x.Name
+		return x.Attributes
 	}
-	return ""
+	return nil
 }
 
-func (x *InsertProductRequest) GetDescription() string {
+type UpdateAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Required      bool                   `protobuf:"varint,2,opt,name=Required,proto3" json:"Required,omitempty"`
+	AllowedValues []string               `protobuf:"bytes,3,rep,name=AllowedValues,proto3" json:"AllowedValues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAttributeRequest) Reset() {
+	*x = UpdateAttributeRequest{}
+	mi := &file_iims_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAttributeRequest) ProtoMessage() {}
+
+func (x *UpdateAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[83]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAttributeRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *UpdateAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *InsertProductRequest) GetCreationDate() string {
+func (x *UpdateAttributeRequest) GetRequired() bool {
 	if x != nil {
-		return x.CreationDate
+		return x.Required
+	}
+	return false
+}
+
+func (x *UpdateAttributeRequest) GetAllowedValues() []string {
+	if x != nil {
+		return x.AllowedValues
+	}
+	return nil
+}
+
+type DeleteAttributeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAttributeRequest) Reset() {
+	*x = DeleteAttributeRequest{}
+	mi := &file_iims_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAttributeRequest) ProtoMessage() {}
+
+func (x *DeleteAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAttributeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *DeleteAttributeRequest) GetId() string {
+	if x != nil {
+		return x.Id
 	}
 	return ""
 }
 
-func (x *InsertProductRequest) GetPrice() float32 {
+type PriceTierMessage struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MinQuantity   int64                  `protobuf:"varint,1,opt,name=MinQuantity,proto3" json:"MinQuantity,omitempty"`
+	Price         *Money                 `protobuf:"bytes,2,opt,name=Price,proto3" json:"Price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PriceTierMessage) Reset() {
+	*x = PriceTierMessage{}
+	mi := &file_iims_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PriceTierMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PriceTierMessage) ProtoMessage() {}
+
+func (x *PriceTierMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[85]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PriceTierMessage.ProtoReflect.Descriptor instead.
+func (*PriceTierMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PriceTierMessage) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
 	}
 	return 0
 }
 
-type GetByProductCodeRequest struct {
+func (x *PriceTierMessage) GetPrice() *Money {
+	if x != nil {
+		return x.Price
+	}
+	return nil
+}
+
+type PriceListItemMessage struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Tiers         []*PriceTierMessage    `protobuf:"bytes,2,rep,name=Tiers,proto3" json:"Tiers,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByProductCodeRequest) Reset() {
-	*x = GetByProductCodeRequest{}
-	mi := &file_iims_proto_msgTypes[1]
+func (x *PriceListItemMessage) Reset() {
+	*x = PriceListItemMessage{}
+	mi := &file_iims_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByProductCodeRequest) String() string {
+func (x *PriceListItemMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByProductCodeRequest) ProtoMessage() {}
+func (*PriceListItemMessage) ProtoMessage() {}
 
-func (x *GetByProductCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[1]
+func (x *PriceListItemMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -122,40 +6538,131 @@ func (x *GetByProductCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByProductCodeRequest.ProtoReflect.Descriptor instead.
-func (*GetByProductCodeRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use PriceListItemMessage.ProtoReflect.Descriptor instead.
+func (*PriceListItemMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *GetByProductCodeRequest) GetCode() string {
+func (x *PriceListItemMessage) GetProductId() string {
 	if x != nil {
-		return x.Code
+		return x.ProductId
 	}
 	return ""
 }
 
-type GetByIdProductRequest struct {
+func (x *PriceListItemMessage) GetTiers() []*PriceTierMessage {
+	if x != nil {
+		return x.Tiers
+	}
+	return nil
+}
+
+type InsertPriceListRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Name          string                  `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Channel       SalesChannel            `protobuf:"varint,2,opt,name=Channel,proto3,enum=iims.SalesChannel" json:"Channel,omitempty"`
+	Priority      int32                   `protobuf:"varint,3,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	ValidFrom     *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=ValidFrom,proto3" json:"ValidFrom,omitempty"`
+	ValidTo       *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=ValidTo,proto3" json:"ValidTo,omitempty"`
+	Items         []*PriceListItemMessage `protobuf:"bytes,6,rep,name=Items,proto3" json:"Items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *InsertPriceListRequest) Reset() {
+	*x = InsertPriceListRequest{}
+	mi := &file_iims_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *InsertPriceListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InsertPriceListRequest) ProtoMessage() {}
+
+func (x *InsertPriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertPriceListRequest.ProtoReflect.Descriptor instead.
+func (*InsertPriceListRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *InsertPriceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *InsertPriceListRequest) GetChannel() SalesChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return SalesChannel_SALES_CHANNEL_UNSPECIFIED
+}
+
+func (x *InsertPriceListRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *InsertPriceListRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *InsertPriceListRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *InsertPriceListRequest) GetItems() []*PriceListItemMessage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type InsertPriceListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetByIdProductRequest) Reset() {
-	*x = GetByIdProductRequest{}
-	mi := &file_iims_proto_msgTypes[2]
+func (x *InsertPriceListResponse) Reset() {
+	*x = InsertPriceListResponse{}
+	mi := &file_iims_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetByIdProductRequest) String() string {
+func (x *InsertPriceListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetByIdProductRequest) ProtoMessage() {}
+func (*InsertPriceListResponse) ProtoMessage() {}
 
-func (x *GetByIdProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[2]
+func (x *InsertPriceListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -166,40 +6673,42 @@ func (x *GetByIdProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetByIdProductRequest.ProtoReflect.Descriptor instead.
-func (*GetByIdProductRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use InsertPriceListResponse.ProtoReflect.Descriptor instead.
+func (*InsertPriceListResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetByIdProductRequest) GetId() string {
+func (x *InsertPriceListResponse) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type InsertProductResponse struct {
+type GetPriceListsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Limit         int64                  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
+	Offset        int64                  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Channel       SalesChannel           `protobuf:"varint,3,opt,name=Channel,proto3,enum=iims.SalesChannel" json:"Channel,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertProductResponse) Reset() {
-	*x = InsertProductResponse{}
-	mi := &file_iims_proto_msgTypes[3]
+func (x *GetPriceListsRequest) Reset() {
+	*x = GetPriceListsRequest{}
+	mi := &file_iims_proto_msgTypes[89]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertProductResponse) String() string {
+func (x *GetPriceListsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertProductResponse) ProtoMessage() {}
+func (*GetPriceListsRequest) ProtoMessage() {}
 
-func (x *InsertProductResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[3]
+func (x *GetPriceListsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[89]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -210,41 +6719,54 @@ func (x *InsertProductResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertProductResponse.ProtoReflect.Descriptor instead.
-func (*InsertProductResponse) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use GetPriceListsRequest.ProtoReflect.Descriptor instead.
+func (*GetPriceListsRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *GetPriceListsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetPriceListsRequest) GetOffset() int64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
 }
 
-func (x *InsertProductResponse) GetId() string {
+func (x *GetPriceListsRequest) GetChannel() SalesChannel {
 	if x != nil {
-		return x.Id
+		return x.Channel
 	}
-	return ""
+	return SalesChannel_SALES_CHANNEL_UNSPECIFIED
 }
 
-type GetProductsRequest struct {
+type GetByIdPriceListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int64                  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
-	Offset        int64                  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductsRequest) Reset() {
-	*x = GetProductsRequest{}
-	mi := &file_iims_proto_msgTypes[4]
+func (x *GetByIdPriceListRequest) Reset() {
+	*x = GetByIdPriceListRequest{}
+	mi := &file_iims_proto_msgTypes[90]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductsRequest) String() string {
+func (x *GetByIdPriceListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductsRequest) ProtoMessage() {}
+func (*GetByIdPriceListRequest) ProtoMessage() {}
 
-func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[4]
+func (x *GetByIdPriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[90]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -255,51 +6777,46 @@ func (x *GetProductsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductsRequest.ProtoReflect.Descriptor instead.
-func (*GetProductsRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{4}
-}
-
-func (x *GetProductsRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use GetByIdPriceListRequest.ProtoReflect.Descriptor instead.
+func (*GetByIdPriceListRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetProductsRequest) GetOffset() int64 {
+func (x *GetByIdPriceListRequest) GetId() string {
 	if x != nil {
-		return x.Offset
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-type GetProductMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	CreationDate  string                 `protobuf:"bytes,4,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
-	Price         string                 `protobuf:"bytes,5,opt,name=Price,proto3" json:"Price,omitempty"`
+type GetPriceListMessage struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Channel       SalesChannel            `protobuf:"varint,3,opt,name=Channel,proto3,enum=iims.SalesChannel" json:"Channel,omitempty"`
+	Priority      int32                   `protobuf:"varint,4,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	ValidFrom     *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=ValidFrom,proto3" json:"ValidFrom,omitempty"`
+	ValidTo       *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=ValidTo,proto3" json:"ValidTo,omitempty"`
+	Items         []*PriceListItemMessage `protobuf:"bytes,7,rep,name=Items,proto3" json:"Items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductMessage) Reset() {
-	*x = GetProductMessage{}
-	mi := &file_iims_proto_msgTypes[5]
+func (x *GetPriceListMessage) Reset() {
+	*x = GetPriceListMessage{}
+	mi := &file_iims_proto_msgTypes[91]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductMessage) String() string {
+func (x *GetPriceListMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductMessage) ProtoMessage() {}
+func (*GetPriceListMessage) ProtoMessage() {}
 
-func (x *GetProductMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[5]
+func (x *GetPriceListMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[91]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,68 +6827,82 @@ func (x *GetProductMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductMessage.ProtoReflect.Descriptor instead.
-func (*GetProductMessage) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetPriceListMessage.ProtoReflect.Descriptor instead.
+func (*GetPriceListMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{91}
 }
 
-func (x *GetProductMessage) GetId() string {
+func (x *GetPriceListMessage) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *GetProductMessage) GetName() string {
+func (x *GetPriceListMessage) GetName() string {
 	if x != nil {
 		return x.Name
 	}
 	return ""
 }
 
-func (x *GetProductMessage) GetDescription() string {
+func (x *GetPriceListMessage) GetChannel() SalesChannel {
 	if x != nil {
-		return x.Description
+		return x.Channel
 	}
-	return ""
+	return SalesChannel_SALES_CHANNEL_UNSPECIFIED
 }
 
-func (x *GetProductMessage) GetCreationDate() string {
+func (x *GetPriceListMessage) GetPriority() int32 {
 	if x != nil {
-		return x.CreationDate
+		return x.Priority
 	}
-	return ""
+	return 0
 }
 
-func (x *GetProductMessage) GetPrice() string {
+func (x *GetPriceListMessage) GetValidFrom() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Price
+		return x.ValidFrom
 	}
-	return ""
+	return nil
 }
 
-type GetProductsResponse struct {
+func (x *GetPriceListMessage) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *GetPriceListMessage) GetItems() []*PriceListItemMessage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type GetPriceListsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Products      []*GetProductMessage   `protobuf:"bytes,1,rep,name=Products,proto3" json:"Products,omitempty"`
+	PriceLists    []*GetPriceListMessage `protobuf:"bytes,1,rep,name=PriceLists,proto3" json:"PriceLists,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetProductsResponse) Reset() {
-	*x = GetProductsResponse{}
-	mi := &file_iims_proto_msgTypes[6]
+func (x *GetPriceListsResponse) Reset() {
+	*x = GetPriceListsResponse{}
+	mi := &file_iims_proto_msgTypes[92]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetProductsResponse) String() string {
+func (x *GetPriceListsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetProductsResponse) ProtoMessage() {}
+func (*GetPriceListsResponse) ProtoMessage() {}
 
-func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[6]
+func (x *GetPriceListsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[92]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -382,40 +6913,46 @@ func (x *GetProductsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetProductsResponse.ProtoReflect.Descriptor instead.
-func (*GetProductsResponse) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use GetPriceListsResponse.ProtoReflect.Descriptor instead.
+func (*GetPriceListsResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *GetProductsResponse) GetProducts() []*GetProductMessage {
+func (x *GetPriceListsResponse) GetPriceLists() []*GetPriceListMessage {
 	if x != nil {
-		return x.Products
+		return x.PriceLists
 	}
 	return nil
 }
 
-type DeleteProductRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+type UpdatePriceListRequest struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Id            string                  `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Name          string                  `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
+	Channel       SalesChannel            `protobuf:"varint,3,opt,name=Channel,proto3,enum=iims.SalesChannel" json:"Channel,omitempty"`
+	Priority      int32                   `protobuf:"varint,4,opt,name=Priority,proto3" json:"Priority,omitempty"`
+	ValidFrom     *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=ValidFrom,proto3" json:"ValidFrom,omitempty"`
+	ValidTo       *timestamppb.Timestamp  `protobuf:"bytes,6,opt,name=ValidTo,proto3" json:"ValidTo,omitempty"`
+	Items         []*PriceListItemMessage `protobuf:"bytes,7,rep,name=Items,proto3" json:"Items,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteProductRequest) Reset() {
-	*x = DeleteProductRequest{}
-	mi := &file_iims_proto_msgTypes[7]
+func (x *UpdatePriceListRequest) Reset() {
+	*x = UpdatePriceListRequest{}
+	mi := &file_iims_proto_msgTypes[93]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteProductRequest) String() string {
+func (x *UpdatePriceListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteProductRequest) ProtoMessage() {}
+func (*UpdatePriceListRequest) ProtoMessage() {}
 
-func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[7]
+func (x *UpdatePriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[93]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -426,44 +6963,82 @@ func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
-func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use UpdatePriceListRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePriceListRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *DeleteProductRequest) GetId() string {
+func (x *UpdatePriceListRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-type UpdateProductRequest struct {
+func (x *UpdatePriceListRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdatePriceListRequest) GetChannel() SalesChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return SalesChannel_SALES_CHANNEL_UNSPECIFIED
+}
+
+func (x *UpdatePriceListRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *UpdatePriceListRequest) GetValidFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidFrom
+	}
+	return nil
+}
+
+func (x *UpdatePriceListRequest) GetValidTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ValidTo
+	}
+	return nil
+}
+
+func (x *UpdatePriceListRequest) GetItems() []*PriceListItemMessage {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type DeletePriceListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	CreationDate  string                 `protobuf:"bytes,4,opt,name=CreationDate,proto3" json:"CreationDate,omitempty"`
-	Price         float32                `protobuf:"fixed32,5,opt,name=Price,proto3" json:"Price,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateProductRequest) Reset() {
-	*x = UpdateProductRequest{}
-	mi := &file_iims_proto_msgTypes[8]
+func (x *DeletePriceListRequest) Reset() {
+	*x = DeletePriceListRequest{}
+	mi := &file_iims_proto_msgTypes[94]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateProductRequest) String() string {
+func (x *DeletePriceListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateProductRequest) ProtoMessage() {}
+func (*DeletePriceListRequest) ProtoMessage() {}
 
-func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[8]
+func (x *DeletePriceListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[94]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -474,68 +7049,42 @@ func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
-func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use DeletePriceListRequest.ProtoReflect.Descriptor instead.
+func (*DeletePriceListRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *UpdateProductRequest) GetId() string {
+func (x *DeletePriceListRequest) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateProductRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *UpdateProductRequest) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *UpdateProductRequest) GetCreationDate() string {
-	if x != nil {
-		return x.CreationDate
-	}
-	return ""
-}
-
-func (x *UpdateProductRequest) GetPrice() float32 {
-	if x != nil {
-		return x.Price
-	}
-	return 0
-}
-
-type BlockProductOperationMessage struct {
+type ResolvePriceRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	Channel       SalesChannel           `protobuf:"varint,2,opt,name=Channel,proto3,enum=iims.SalesChannel" json:"Channel,omitempty"`
+	Quantity      int64                  `protobuf:"varint,3,opt,name=Quantity,proto3" json:"Quantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockProductOperationMessage) Reset() {
-	*x = BlockProductOperationMessage{}
-	mi := &file_iims_proto_msgTypes[9]
+func (x *ResolvePriceRequest) Reset() {
+	*x = ResolvePriceRequest{}
+	mi := &file_iims_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockProductOperationMessage) String() string {
+func (x *ResolvePriceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockProductOperationMessage) ProtoMessage() {}
+func (*ResolvePriceRequest) ProtoMessage() {}
 
-func (x *BlockProductOperationMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[9]
+func (x *ResolvePriceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -546,43 +7095,60 @@ func (x *BlockProductOperationMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockProductOperationMessage.ProtoReflect.Descriptor instead.
-func (*BlockProductOperationMessage) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ResolvePriceRequest.ProtoReflect.Descriptor instead.
+func (*ResolvePriceRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *BlockProductOperationMessage) GetId() string {
+func (x *ResolvePriceRequest) GetProductId() string {
 	if x != nil {
-		return x.Id
+		return x.ProductId
 	}
 	return ""
 }
 
-type InsertSaleRequest struct {
+func (x *ResolvePriceRequest) GetChannel() SalesChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return SalesChannel_SALES_CHANNEL_UNSPECIFIED
+}
+
+func (x *ResolvePriceRequest) GetQuantity() int64 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// ResolvePriceResponse carries the unit price for the quantity and the total of the line.
+// PriceListId is empty when the base product price applies.
+type ResolvePriceResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string                 `protobuf:"bytes,2,opt,name=Description,proto3" json:"Description,omitempty"`
-	SaleSize      int32                  `protobuf:"varint,3,opt,name=SaleSize,proto3" json:"SaleSize,omitempty"`
-	Product       string                 `protobuf:"bytes,4,opt,name=Product,proto3" json:"Product,omitempty"`
+	ProductId     string                 `protobuf:"bytes,1,opt,name=ProductId,proto3" json:"ProductId,omitempty"`
+	UnitPrice     *Money                 `protobuf:"bytes,2,opt,name=UnitPrice,proto3" json:"UnitPrice,omitempty"`
+	Total         *Money                 `protobuf:"bytes,3,opt,name=Total,proto3" json:"Total,omitempty"`
+	PriceListId   string                 `protobuf:"bytes,4,opt,name=PriceListId,proto3" json:"PriceListId,omitempty"`
+	MinQuantity   int64                  `protobuf:"varint,5,opt,name=MinQuantity,proto3" json:"MinQuantity,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *InsertSaleRequest) Reset() {
-	*x = InsertSaleRequest{}
-	mi := &file_iims_proto_msgTypes[10]
+func (x *ResolvePriceResponse) Reset() {
+	*x = ResolvePriceResponse{}
+	mi := &file_iims_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSaleRequest) String() string {
+func (x *ResolvePriceResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSaleRequest) ProtoMessage() {}
+func (*ResolvePriceResponse) ProtoMessage() {}
 
-func (x *InsertSaleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[10]
+func (x *ResolvePriceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -593,61 +7159,73 @@ func (x *InsertSaleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InsertSaleRequest.ProtoReflect.Descriptor instead.
-func (*InsertSaleRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use ResolvePriceResponse.ProtoReflect.Descriptor instead.
+func (*ResolvePriceResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *InsertSaleRequest) GetName() string {
+func (x *ResolvePriceResponse) GetProductId() string {
 	if x != nil {
-		return x.Name
+		return x.ProductId
 	}
 	return ""
 }
 
-func (x *InsertSaleRequest) GetDescription() string {
+func (x *ResolvePriceResponse) GetUnitPrice() *Money {
 	if x != nil {
-		return x.Description
+		return x.UnitPrice
 	}
-	return ""
+	return nil
 }
 
-func (x *InsertSaleRequest) GetSaleSize() int32 {
+func (x *ResolvePriceResponse) GetTotal() *Money {
 	if x != nil {
-		return x.SaleSize
+		return x.Total
 	}
-	return 0
+	return nil
 }
 
-func (x *InsertSaleRequest) GetProduct() string {
+func (x *ResolvePriceResponse) GetPriceListId() string {
 	if x != nil {
-		return x.Product
+		return x.PriceListId
 	}
 	return ""
 }
 
-type InsertSaleResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ResolvePriceResponse) GetMinQuantity() int64 {
+	if x != nil {
+		return x.MinQuantity
+	}
+	return 0
 }
 
-func (x *InsertSaleResponse) Reset() {
-	*x = InsertSaleResponse{}
-	mi := &file_iims_proto_msgTypes[11]
+// InsertCouponRequest leaves a limit off when it is zero and never expires without ExpiresAt.
+type InsertCouponRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Code           string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	SaleId         string                 `protobuf:"bytes,2,opt,name=SaleId,proto3" json:"SaleId,omitempty"`
+	MaxRedemptions int64                  `protobuf:"varint,3,opt,name=MaxRedemptions,proto3" json:"MaxRedemptions,omitempty"`
+	MaxPerCustomer int64                  `protobuf:"varint,4,opt,name=MaxPerCustomer,proto3" json:"MaxPerCustomer,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *InsertCouponRequest) Reset() {
+	*x = InsertCouponRequest{}
+	mi := &file_iims_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *InsertSaleResponse) String() string {
+func (x *InsertCouponRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InsertSaleResponse) ProtoMessage() {}
+func (*InsertCouponRequest) ProtoMessage() {}
 
-func (x *InsertSaleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[11]
+func (x *InsertCouponRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -655,44 +7233,71 @@ func (x *InsertSaleResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InsertCouponRequest.ProtoReflect.Descriptor instead.
+func (*InsertCouponRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *InsertCouponRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InsertCouponRequest) GetSaleId() string {
+	if x != nil {
+		return x.SaleId
+	}
+	return ""
+}
+
+func (x *InsertCouponRequest) GetMaxRedemptions() int64 {
+	if x != nil {
+		return x.MaxRedemptions
+	}
+	return 0
 }
 
-// Deprecated: Use InsertSaleResponse.ProtoReflect.Descriptor instead.
-func (*InsertSaleResponse) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{11}
+func (x *InsertCouponRequest) GetMaxPerCustomer() int64 {
+	if x != nil {
+		return x.MaxPerCustomer
+	}
+	return 0
 }
 
-func (x *InsertSaleResponse) GetId() string {
+func (x *InsertCouponRequest) GetExpiresAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.ExpiresAt
 	}
-	return ""
+	return nil
 }
 
-type GetSalesRequest struct {
+type InsertCouponResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Limit         int64                  `protobuf:"varint,1,opt,name=Limit,proto3" json:"Limit,omitempty"`
-	Offset        int64                  `protobuf:"varint,2,opt,name=Offset,proto3" json:"Offset,omitempty"`
+	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSalesRequest) Reset() {
-	*x = GetSalesRequest{}
-	mi := &file_iims_proto_msgTypes[12]
+func (x *InsertCouponResponse) Reset() {
+	*x = InsertCouponResponse{}
+	mi := &file_iims_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSalesRequest) String() string {
+func (x *InsertCouponResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSalesRequest) ProtoMessage() {}
+func (*InsertCouponResponse) ProtoMessage() {}
 
-func (x *GetSalesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[12]
+func (x *InsertCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -703,51 +7308,49 @@ func (x *GetSalesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSalesRequest.ProtoReflect.Descriptor instead.
-func (*GetSalesRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *GetSalesRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
+// Deprecated: Use InsertCouponResponse.ProtoReflect.Descriptor instead.
+func (*InsertCouponResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetSalesRequest) GetOffset() int64 {
+func (x *InsertCouponResponse) GetId() string {
 	if x != nil {
-		return x.Offset
+		return x.Id
 	}
-	return 0
-}
-
-type GetSaleMessage struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	SaleSize      int32                  `protobuf:"varint,4,opt,name=SaleSize,proto3" json:"SaleSize,omitempty"`
-	Product       string                 `protobuf:"bytes,5,opt,name=Product,proto3" json:"Product,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetSaleMessage) Reset() {
-	*x = GetSaleMessage{}
-	mi := &file_iims_proto_msgTypes[13]
+// GenerateCouponsRequest creates Count coupons with unique random codes of Length characters
+// after Prefix. Prefix and code together are at most 64 characters. Every coupon gets the same
+// sale, limits and expiry.
+type GenerateCouponsRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	SaleId         string                 `protobuf:"bytes,1,opt,name=SaleId,proto3" json:"SaleId,omitempty"`
+	Count          int32                  `protobuf:"varint,2,opt,name=Count,proto3" json:"Count,omitempty"`
+	Prefix         string                 `protobuf:"bytes,3,opt,name=Prefix,proto3" json:"Prefix,omitempty"`
+	Length         int32                  `protobuf:"varint,4,opt,name=Length,proto3" json:"Length,omitempty"`
+	MaxRedemptions int64                  `protobuf:"varint,5,opt,name=MaxRedemptions,proto3" json:"MaxRedemptions,omitempty"`
+	MaxPerCustomer int64                  `protobuf:"varint,6,opt,name=MaxPerCustomer,proto3" json:"MaxPerCustomer,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GenerateCouponsRequest) Reset() {
+	*x = GenerateCouponsRequest{}
+	mi := &file_iims_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSaleMessage) String() string {
+func (x *GenerateCouponsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSaleMessage) ProtoMessage() {}
+func (*GenerateCouponsRequest) ProtoMessage() {}
 
-func (x *GetSaleMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[13]
+func (x *GenerateCouponsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,68 +7361,82 @@ func (x *GetSaleMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSaleMessage.ProtoReflect.Descriptor instead.
-func (*GetSaleMessage) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GenerateCouponsRequest.ProtoReflect.Descriptor instead.
+func (*GenerateCouponsRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *GetSaleMessage) GetId() string {
+func (x *GenerateCouponsRequest) GetSaleId() string {
 	if x != nil {
-		return x.Id
+		return x.SaleId
 	}
 	return ""
 }
 
-func (x *GetSaleMessage) GetName() string {
+func (x *GenerateCouponsRequest) GetCount() int32 {
 	if x != nil {
-		return x.Name
+		return x.Count
 	}
-	return ""
+	return 0
 }
 
-func (x *GetSaleMessage) GetDescription() string {
+func (x *GenerateCouponsRequest) GetPrefix() string {
 	if x != nil {
-		return x.Description
+		return x.Prefix
 	}
 	return ""
 }
 
-func (x *GetSaleMessage) GetSaleSize() int32 {
+func (x *GenerateCouponsRequest) GetLength() int32 {
 	if x != nil {
-		return x.SaleSize
+		return x.Length
 	}
 	return 0
 }
 
-func (x *GetSaleMessage) GetProduct() string {
+func (x *GenerateCouponsRequest) GetMaxRedemptions() int64 {
 	if x != nil {
-		return x.Product
+		return x.MaxRedemptions
 	}
-	return ""
+	return 0
 }
 
-type GetSalesResponse struct {
+func (x *GenerateCouponsRequest) GetMaxPerCustomer() int64 {
+	if x != nil {
+		return x.MaxPerCustomer
+	}
+	return 0
+}
+
+func (x *GenerateCouponsRequest) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type GenerateCouponsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Sales         []*GetSaleMessage      `protobuf:"bytes,1,rep,name=Sales,proto3" json:"Sales,omitempty"`
+	Codes         []string               `protobuf:"bytes,1,rep,name=Codes,proto3" json:"Codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSalesResponse) Reset() {
-	*x = GetSalesResponse{}
-	mi := &file_iims_proto_msgTypes[14]
+func (x *GenerateCouponsResponse) Reset() {
+	*x = GenerateCouponsResponse{}
+	mi := &file_iims_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSalesResponse) String() string {
+func (x *GenerateCouponsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSalesResponse) ProtoMessage() {}
+func (*GenerateCouponsResponse) ProtoMessage() {}
 
-func (x *GetSalesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[14]
+func (x *GenerateCouponsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,40 +7447,42 @@ func (x *GetSalesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSalesResponse.ProtoReflect.Descriptor instead.
-func (*GetSalesResponse) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use GenerateCouponsResponse.ProtoReflect.Descriptor instead.
+func (*GenerateCouponsResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *GetSalesResponse) GetSales() []*GetSaleMessage {
+func (x *GenerateCouponsResponse) GetCodes() []string {
 	if x != nil {
-		return x.Sales
+		return x.Codes
 	}
 	return nil
 }
 
-type DeleteSaleRequest struct {
+// CouponCodeRequest names the customer, which is required for coupons limited per customer.
+type CouponCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Code          string                 `protobuf:"bytes,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	CustomerId    string                 `protobuf:"bytes,2,opt,name=CustomerId,proto3" json:"CustomerId,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteSaleRequest) Reset() {
-	*x = DeleteSaleRequest{}
-	mi := &file_iims_proto_msgTypes[15]
+func (x *CouponCodeRequest) Reset() {
+	*x = CouponCodeRequest{}
+	mi := &file_iims_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteSaleRequest) String() string {
+func (x *CouponCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteSaleRequest) ProtoMessage() {}
+func (*CouponCodeRequest) ProtoMessage() {}
 
-func (x *DeleteSaleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[15]
+func (x *CouponCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -874,43 +7493,53 @@ func (x *DeleteSaleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteSaleRequest.ProtoReflect.Descriptor instead.
-func (*DeleteSaleRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use CouponCodeRequest.ProtoReflect.Descriptor instead.
+func (*CouponCodeRequest) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *DeleteSaleRequest) GetId() string {
+func (x *CouponCodeRequest) GetCode() string {
 	if x != nil {
-		return x.Id
+		return x.Code
 	}
 	return ""
 }
 
-type UpdateSaleRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=Name,proto3" json:"Name,omitempty"`
-	Description   string                 `protobuf:"bytes,3,opt,name=Description,proto3" json:"Description,omitempty"`
-	SaleSize      int32                  `protobuf:"varint,4,opt,name=SaleSize,proto3" json:"SaleSize,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CouponCodeRequest) GetCustomerId() string {
+	if x != nil {
+		return x.CustomerId
+	}
+	return ""
 }
 
-func (x *UpdateSaleRequest) Reset() {
-	*x = UpdateSaleRequest{}
-	mi := &file_iims_proto_msgTypes[16]
+type CouponMessage struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=Code,proto3" json:"Code,omitempty"`
+	SaleId         string                 `protobuf:"bytes,3,opt,name=SaleId,proto3" json:"SaleId,omitempty"`
+	MaxRedemptions int64                  `protobuf:"varint,4,opt,name=MaxRedemptions,proto3" json:"MaxRedemptions,omitempty"`
+	MaxPerCustomer int64                  `protobuf:"varint,5,opt,name=MaxPerCustomer,proto3" json:"MaxPerCustomer,omitempty"`
+	ExpiresAt      *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=ExpiresAt,proto3" json:"ExpiresAt,omitempty"`
+	Redemptions    int64                  `protobuf:"varint,7,opt,name=Redemptions,proto3" json:"Redemptions,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CouponMessage) Reset() {
+	*x = CouponMessage{}
+	mi := &file_iims_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateSaleRequest) String() string {
+func (x *CouponMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateSaleRequest) ProtoMessage() {}
+func (*CouponMessage) ProtoMessage() {}
 
-func (x *UpdateSaleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[16]
+func (x *CouponMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -921,61 +7550,84 @@ func (x *UpdateSaleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateSaleRequest.ProtoReflect.Descriptor instead.
-func (*UpdateSaleRequest) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use CouponMessage.ProtoReflect.Descriptor instead.
+func (*CouponMessage) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *UpdateSaleRequest) GetId() string {
+func (x *CouponMessage) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *UpdateSaleRequest) GetName() string {
+func (x *CouponMessage) GetCode() string {
 	if x != nil {
-		return x.Name
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateSaleRequest) GetDescription() string {
+func (x *CouponMessage) GetSaleId() string {
 	if x != nil {
-		return x.Description
+		return x.SaleId
 	}
 	return ""
 }
 
-func (x *UpdateSaleRequest) GetSaleSize() int32 {
+func (x *CouponMessage) GetMaxRedemptions() int64 {
 	if x != nil {
-		return x.SaleSize
+		return x.MaxRedemptions
 	}
 	return 0
 }
 
-type BlockSaleOperationMessage struct {
+func (x *CouponMessage) GetMaxPerCustomer() int64 {
+	if x != nil {
+		return x.MaxPerCustomer
+	}
+	return 0
+}
+
+func (x *CouponMessage) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CouponMessage) GetRedemptions() int64 {
+	if x != nil {
+		return x.Redemptions
+	}
+	return 0
+}
+
+type ValidateCouponResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=Id,proto3" json:"Id,omitempty"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=Valid,proto3" json:"Valid,omitempty"`
+	Rejection     CouponRejection        `protobuf:"varint,2,opt,name=Rejection,proto3,enum=iims.CouponRejection" json:"Rejection,omitempty"`
+	Coupon        *CouponMessage         `protobuf:"bytes,3,opt,name=Coupon,proto3" json:"Coupon,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *BlockSaleOperationMessage) Reset() {
-	*x = BlockSaleOperationMessage{}
-	mi := &file_iims_proto_msgTypes[17]
+func (x *ValidateCouponResponse) Reset() {
+	*x = ValidateCouponResponse{}
+	mi := &file_iims_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *BlockSaleOperationMessage) String() string {
+func (x *ValidateCouponResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockSaleOperationMessage) ProtoMessage() {}
+func (*ValidateCouponResponse) ProtoMessage() {}
 
-func (x *BlockSaleOperationMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_iims_proto_msgTypes[17]
+func (x *ValidateCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -986,16 +7638,74 @@ func (x *BlockSaleOperationMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockSaleOperationMessage.ProtoReflect.Descriptor instead.
-func (*BlockSaleOperationMessage) Descriptor() ([]byte, []int) {
-	return file_iims_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ValidateCouponResponse.ProtoReflect.Descriptor instead.
+func (*ValidateCouponResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *BlockSaleOperationMessage) GetId() string {
+func (x *ValidateCouponResponse) GetValid() bool {
 	if x != nil {
-		return x.Id
+		return x.Valid
 	}
-	return ""
+	return false
+}
+
+func (x *ValidateCouponResponse) GetRejection() CouponRejection {
+	if x != nil {
+		return x.Rejection
+	}
+	return CouponRejection_COUPON_REJECTION_UNSPECIFIED
+}
+
+func (x *ValidateCouponResponse) GetCoupon() *CouponMessage {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
+}
+
+type RedeemCouponResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Coupon        *CouponMessage         `protobuf:"bytes,1,opt,name=Coupon,proto3" json:"Coupon,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RedeemCouponResponse) Reset() {
+	*x = RedeemCouponResponse{}
+	mi := &file_iims_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeemCouponResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeemCouponResponse) ProtoMessage() {}
+
+func (x *RedeemCouponResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_iims_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeemCouponResponse.ProtoReflect.Descriptor instead.
+func (*RedeemCouponResponse) Descriptor() ([]byte, []int) {
+	return file_iims_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RedeemCouponResponse) GetCoupon() *CouponMessage {
+	if x != nil {
+		return x.Coupon
+	}
+	return nil
 }
 
 var File_iims_proto protoreflect.FileDescriptor
@@ -1003,82 +7713,737 @@ var File_iims_proto protoreflect.FileDescriptor
 const file_iims_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"iims.proto\x12\x04iims\x1a\x1bgoogle/protobuf/empty.proto\"\x86\x01\n" +
+	"iims.proto\x12\x04iims\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1fgoogle/protobuf/timestamp.proto\";\n" +
+	"\x05Money\x12\x16\n" +
+	"\x06Amount\x18\x01 \x01(\x03R\x06Amount\x12\x1a\n" +
+	"\bCurrency\x18\x02 \x01(\tR\bCurrency\"\x82\x03\n" +
 	"\x14InsertProductRequest\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\"\n" +
-	"\fCreationDate\x18\x03 \x01(\tR\fCreationDate\x12\x14\n" +
-	"\x05Price\x18\x04 \x01(\x02R\x05Price\"-\n" +
+	"\fCreationDate\x18\x03 \x01(\tR\fCreationDate\x12 \n" +
+	"\vProductCode\x18\x05 \x01(\tR\vProductCode\x12\x1a\n" +
+	"\bBarcodes\x18\x06 \x03(\tR\bBarcodes\x12\x1e\n" +
+	"\n" +
+	"CategoryId\x18\a \x01(\tR\n" +
+	"CategoryId\x12J\n" +
+	"\n" +
+	"Attributes\x18\b \x03(\v2*.iims.InsertProductRequest.AttributesEntryR\n" +
+	"Attributes\x12!\n" +
+	"\x05Price\x18\t \x01(\v2\v.iims.MoneyR\x05Price\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x04\x10\x05\"U\n" +
 	"\x17GetByProductCodeRequest\x12\x12\n" +
-	"\x04code\x18\x01 \x01(\tR\x04code\"'\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12&\n" +
+	"\x0eIncludeDeleted\x18\x02 \x01(\bR\x0eIncludeDeleted\")\n" +
+	"\x13GetByBarcodeRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"\x83\x01\n" +
+	"\x15SearchProductsRequest\x12\x14\n" +
+	"\x05Query\x18\x01 \x01(\tR\x05Query\x12\x14\n" +
+	"\x05Limit\x18\x02 \x01(\x03R\x05Limit\x12\x16\n" +
+	"\x06Offset\x18\x03 \x01(\x03R\x06Offset\x12&\n" +
+	"\x0eIncludeBlocked\x18\x04 \x01(\bR\x0eIncludeBlocked\"_\n" +
+	"\x14ScoredProductMessage\x121\n" +
+	"\aProduct\x18\x01 \x01(\v2\x17.iims.GetProductMessageR\aProduct\x12\x14\n" +
+	"\x05Score\x18\x02 \x01(\x01R\x05Score\"N\n" +
+	"\x16SearchProductsResponse\x124\n" +
+	"\aResults\x18\x01 \x03(\v2\x1a.iims.ScoredProductMessageR\aResults\"O\n" +
 	"\x15GetByIdProductRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"'\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0eIncludeDeleted\x18\x02 \x01(\bR\x0eIncludeDeleted\"'\n" +
 	"\x15InsertProductResponse\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\tR\x02Id\"B\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\x9d\x06\n" +
 	"\x12GetProductsRequest\x12\x14\n" +
 	"\x05Limit\x18\x01 \x01(\x03R\x05Limit\x12\x16\n" +
-	"\x06Offset\x18\x02 \x01(\x03R\x06Offset\"\x93\x01\n" +
+	"\x06Offset\x18\x02 \x01(\x03R\x06Offset\x12\"\n" +
+	"\fNameContains\x18\x03 \x01(\tR\fNameContains\x12\x1d\n" +
+	"\aBlocked\x18\x06 \x01(\bH\x00R\aBlocked\x88\x01\x01\x12<\n" +
+	"\vCreatedFrom\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\vCreatedFrom\x128\n" +
+	"\tCreatedTo\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tCreatedTo\x124\n" +
+	"\tSortField\x18\t \x01(\x0e2\x16.iims.ProductSortFieldR\tSortField\x129\n" +
+	"\rSortDirection\x18\n" +
+	" \x01(\x0e2\x13.iims.SortDirectionR\rSortDirection\x12\x1c\n" +
+	"\tPageToken\x18\v \x01(\tR\tPageToken\x12,\n" +
+	"\x11IncludeTotalCount\x18\f \x01(\bR\x11IncludeTotalCount\x12\x1e\n" +
+	"\n" +
+	"CategoryId\x18\r \x01(\tR\n" +
+	"CategoryId\x12H\n" +
+	"\n" +
+	"Attributes\x18\x0e \x03(\v2(.iims.GetProductsRequest.AttributesEntryR\n" +
+	"Attributes\x12'\n" +
+	"\bMinPrice\x18\x0f \x01(\v2\v.iims.MoneyR\bMinPrice\x12'\n" +
+	"\bMaxPrice\x18\x10 \x01(\v2\v.iims.MoneyR\bMaxPrice\x12&\n" +
+	"\x0eIncludeDeleted\x18\x11 \x01(\bR\x0eIncludeDeleted\x12&\n" +
+	"\x0eIncludeBlocked\x18\x12 \x01(\bR\x0eIncludeBlocked\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01B\n" +
+	"\n" +
+	"\b_BlockedJ\x04\b\x04\x10\x05J\x04\b\x05\x10\x06\"\xae\x05\n" +
 	"\x11GetProductMessage\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\"\n" +
-	"\fCreationDate\x18\x04 \x01(\tR\fCreationDate\x12\x14\n" +
-	"\x05Price\x18\x05 \x01(\tR\x05Price\"J\n" +
+	"\fCreationDate\x18\x04 \x01(\tR\fCreationDate\x12 \n" +
+	"\vProductCode\x18\x06 \x01(\tR\vProductCode\x12\x1a\n" +
+	"\bBarcodes\x18\a \x03(\tR\bBarcodes\x12\x1e\n" +
+	"\n" +
+	"CategoryId\x18\b \x01(\tR\n" +
+	"CategoryId\x120\n" +
+	"\bVariants\x18\t \x03(\v2\x14.iims.VariantMessageR\bVariants\x12\x1c\n" +
+	"\tVariantId\x18\n" +
+	" \x01(\tR\tVariantId\x12G\n" +
+	"\n" +
+	"Attributes\x18\v \x03(\v2'.iims.GetProductMessage.AttributesEntryR\n" +
+	"Attributes\x12!\n" +
+	"\x05Price\x18\f \x01(\v2\v.iims.MoneyR\x05Price\x128\n" +
+	"\tDeletedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tDeletedAt\x12\x18\n" +
+	"\aBlocked\x18\x0e \x01(\bR\aBlocked\x12\x1c\n" +
+	"\tBlockedBy\x18\x0f \x01(\tR\tBlockedBy\x128\n" +
+	"\tBlockedAt\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tBlockedAt\x12$\n" +
+	"\rBlockedReason\x18\x11 \x01(\tR\rBlockedReason\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x05\x10\x06\"\xe3\x02\n" +
+	"\x0eVariantMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\tR\tProductId\x12 \n" +
+	"\vProductCode\x18\x03 \x01(\tR\vProductCode\x12D\n" +
+	"\n" +
+	"Attributes\x18\x04 \x03(\v2$.iims.VariantMessage.AttributesEntryR\n" +
+	"Attributes\x12\x18\n" +
+	"\aBarcode\x18\a \x01(\tR\aBarcode\x12!\n" +
+	"\x05Price\x18\b \x01(\v2\v.iims.MoneyR\x05Price\x123\n" +
+	"\x0eEffectivePrice\x18\t \x01(\v2\v.iims.MoneyR\x0eEffectivePrice\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x05\x10\x06J\x04\b\x06\x10\a\"\xa4\x02\n" +
+	"\x14InsertVariantRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12 \n" +
+	"\vProductCode\x18\x02 \x01(\tR\vProductCode\x12J\n" +
+	"\n" +
+	"Attributes\x18\x03 \x03(\v2*.iims.InsertVariantRequest.AttributesEntryR\n" +
+	"Attributes\x12\x18\n" +
+	"\aBarcode\x18\x05 \x01(\tR\aBarcode\x12!\n" +
+	"\x05Price\x18\x06 \x01(\v2\v.iims.MoneyR\x05Price\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x04\x10\x05\"'\n" +
+	"\x15InsertVariantResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\x96\x02\n" +
+	"\x14UpdateVariantRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12 \n" +
+	"\vProductCode\x18\x02 \x01(\tR\vProductCode\x12J\n" +
+	"\n" +
+	"Attributes\x18\x03 \x03(\v2*.iims.UpdateVariantRequest.AttributesEntryR\n" +
+	"Attributes\x12\x18\n" +
+	"\aBarcode\x18\x05 \x01(\tR\aBarcode\x12!\n" +
+	"\x05Price\x18\x06 \x01(\v2\v.iims.MoneyR\x05Price\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x04\x10\x05\"&\n" +
+	"\x14DeleteVariantRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\x9f\x01\n" +
+	"\x1aSchedulePriceChangeRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12!\n" +
+	"\x05Price\x18\x02 \x01(\v2\v.iims.MoneyR\x05Price\x12@\n" +
+	"\rEffectiveFrom\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\rEffectiveFrom\"]\n" +
+	"\x11GetPriceAtRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12*\n" +
+	"\x02At\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02At\"\x96\x02\n" +
+	"\x12PriceChangeMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\tR\tProductId\x12!\n" +
+	"\x05Price\x18\x03 \x01(\v2\v.iims.MoneyR\x05Price\x12/\n" +
+	"\x06Status\x18\x04 \x01(\x0e2\x17.iims.PriceChangeStatusR\x06Status\x12@\n" +
+	"\rEffectiveFrom\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\rEffectiveFrom\x12<\n" +
+	"\vEffectiveTo\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\vEffectiveTo\"\x90\x01\n" +
 	"\x13GetProductsResponse\x123\n" +
-	"\bProducts\x18\x01 \x03(\v2\x17.iims.GetProductMessageR\bProducts\"&\n" +
+	"\bProducts\x18\x01 \x03(\v2\x17.iims.GetProductMessageR\bProducts\x12$\n" +
+	"\rNextPageToken\x18\x02 \x01(\tR\rNextPageToken\x12\x1e\n" +
+	"\n" +
+	"TotalCount\x18\x03 \x01(\x03R\n" +
+	"TotalCount\"&\n" +
 	"\x14DeleteProductRequest\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\tR\x02Id\"\x96\x01\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"'\n" +
+	"\x15RestoreProductRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"%\n" +
+	"\x13PurgeProductRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\x92\x03\n" +
 	"\x14UpdateProductRequest\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\"\n" +
-	"\fCreationDate\x18\x04 \x01(\tR\fCreationDate\x12\x14\n" +
-	"\x05Price\x18\x05 \x01(\x02R\x05Price\".\n" +
+	"\fCreationDate\x18\x04 \x01(\tR\fCreationDate\x12 \n" +
+	"\vProductCode\x18\x06 \x01(\tR\vProductCode\x12\x1a\n" +
+	"\bBarcodes\x18\a \x03(\tR\bBarcodes\x12\x1e\n" +
+	"\n" +
+	"CategoryId\x18\b \x01(\tR\n" +
+	"CategoryId\x12J\n" +
+	"\n" +
+	"Attributes\x18\t \x03(\v2*.iims.UpdateProductRequest.AttributesEntryR\n" +
+	"Attributes\x12!\n" +
+	"\x05Price\x18\n" +
+	" \x01(\v2\v.iims.MoneyR\x05Price\x1a=\n" +
+	"\x0fAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01J\x04\b\x05\x10\x06\"\\\n" +
 	"\x1cBlockProductOperationMessage\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\tR\x02Id\"\x7f\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x14\n" +
+	"\x05Actor\x18\x02 \x01(\tR\x05Actor\x12\x16\n" +
+	"\x06Reason\x18\x03 \x01(\tR\x06Reason\"6\n" +
+	"\x16GetAvailabilityRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\"\xd2\x01\n" +
+	"\x1cWarehouseAvailabilityMessage\x12 \n" +
+	"\vWarehouseId\x18\x01 \x01(\tR\vWarehouseId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1a\n" +
+	"\bReserved\x18\x03 \x01(\x03R\bReserved\x12\x1c\n" +
+	"\tAvailable\x18\x04 \x01(\x03R\tAvailable\x12\x1c\n" +
+	"\tInTransit\x18\x05 \x01(\x03R\tInTransit\x12\x1c\n" +
+	"\tVariantId\x18\x06 \x01(\tR\tVariantId\"\xf2\x01\n" +
+	"\x1aProductAvailabilityMessage\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1a\n" +
+	"\bReserved\x18\x03 \x01(\x03R\bReserved\x12\x1c\n" +
+	"\tAvailable\x18\x04 \x01(\x03R\tAvailable\x12B\n" +
+	"\n" +
+	"Warehouses\x18\x05 \x03(\v2\".iims.WarehouseAvailabilityMessageR\n" +
+	"Warehouses\x12\x1c\n" +
+	"\tInTransit\x18\x06 \x01(\x03R\tInTransit\"\xe4\x04\n" +
 	"\x11InsertSaleRequest\x12\x12\n" +
 	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x1a\n" +
 	"\bSaleSize\x18\x03 \x01(\x05R\bSaleSize\x12\x18\n" +
-	"\aProduct\x18\x04 \x01(\tR\aProduct\"$\n" +
+	"\aProduct\x18\x04 \x01(\tR\aProduct\x126\n" +
+	"\bStartsAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bStartsAt\x122\n" +
+	"\x06EndsAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06EndsAt\x126\n" +
+	"\fDiscountType\x18\a \x01(\x0e2\x12.iims.DiscountTypeR\fDiscountType\x12#\n" +
+	"\x06Amount\x18\b \x01(\v2\v.iims.MoneyR\x06Amount\x12 \n" +
+	"\vBuyQuantity\x18\t \x01(\x03R\vBuyQuantity\x12\"\n" +
+	"\fFreeQuantity\x18\n" +
+	" \x01(\x03R\fFreeQuantity\x12&\n" +
+	"\x0eBundleProducts\x18\v \x03(\tR\x0eBundleProducts\x12(\n" +
+	"\x06Target\x18\f \x01(\x0e2\x10.iims.SaleTargetR\x06Target\x12\x1a\n" +
+	"\bProducts\x18\r \x03(\tR\bProducts\x12\x1e\n" +
+	"\n" +
+	"Categories\x18\x0e \x03(\tR\n" +
+	"Categories\x12*\n" +
+	"\x10ExcludedProducts\x18\x0f \x03(\tR\x10ExcludedProducts\x12\x1a\n" +
+	"\bVariants\x18\x10 \x03(\tR\bVariants\"$\n" +
 	"\x12InsertSaleResponse\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\tR\x02Id\"?\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\xb1\x02\n" +
 	"\x0fGetSalesRequest\x12\x14\n" +
 	"\x05Limit\x18\x01 \x01(\x03R\x05Limit\x12\x16\n" +
-	"\x06Offset\x18\x02 \x01(\x03R\x06Offset\"\x8c\x01\n" +
+	"\x06Offset\x18\x02 \x01(\x03R\x06Offset\x12\x1c\n" +
+	"\tPageToken\x18\x03 \x01(\tR\tPageToken\x12,\n" +
+	"\x11IncludeTotalCount\x18\x04 \x01(\bR\x11IncludeTotalCount\x126\n" +
+	"\bActiveAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bActiveAt\x12\x1c\n" +
+	"\tProductId\x18\x06 \x01(\tR\tProductId\x12&\n" +
+	"\x0eIncludeDeleted\x18\a \x01(\bR\x0eIncludeDeleted\x12&\n" +
+	"\x0eIncludeBlocked\x18\b \x01(\bR\x0eIncludeBlocked\"\xed\x06\n" +
 	"\x0eGetSaleMessage\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\x1a\n" +
 	"\bSaleSize\x18\x04 \x01(\x05R\bSaleSize\x12\x18\n" +
-	"\aProduct\x18\x05 \x01(\tR\aProduct\">\n" +
+	"\aProduct\x18\x05 \x01(\tR\aProduct\x126\n" +
+	"\bStartsAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\bStartsAt\x122\n" +
+	"\x06EndsAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x06EndsAt\x12(\n" +
+	"\x06Status\x18\b \x01(\x0e2\x10.iims.SaleStatusR\x06Status\x126\n" +
+	"\fDiscountType\x18\t \x01(\x0e2\x12.iims.DiscountTypeR\fDiscountType\x12#\n" +
+	"\x06Amount\x18\n" +
+	" \x01(\v2\v.iims.MoneyR\x06Amount\x12 \n" +
+	"\vBuyQuantity\x18\v \x01(\x03R\vBuyQuantity\x12\"\n" +
+	"\fFreeQuantity\x18\f \x01(\x03R\fFreeQuantity\x12&\n" +
+	"\x0eBundleProducts\x18\r \x03(\tR\x0eBundleProducts\x12(\n" +
+	"\x06Target\x18\x0e \x01(\x0e2\x10.iims.SaleTargetR\x06Target\x12\x1a\n" +
+	"\bProducts\x18\x0f \x03(\tR\bProducts\x12\x1e\n" +
+	"\n" +
+	"Categories\x18\x10 \x03(\tR\n" +
+	"Categories\x12*\n" +
+	"\x10ExcludedProducts\x18\x11 \x03(\tR\x10ExcludedProducts\x128\n" +
+	"\tDeletedAt\x18\x12 \x01(\v2\x1a.google.protobuf.TimestampR\tDeletedAt\x12\x18\n" +
+	"\aBlocked\x18\x13 \x01(\bR\aBlocked\x12\x1c\n" +
+	"\tBlockedBy\x18\x14 \x01(\tR\tBlockedBy\x128\n" +
+	"\tBlockedAt\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tBlockedAt\x12$\n" +
+	"\rBlockedReason\x18\x16 \x01(\tR\rBlockedReason\x12\x1a\n" +
+	"\bVariants\x18\x17 \x03(\tR\bVariants\"\x84\x01\n" +
 	"\x10GetSalesResponse\x12*\n" +
-	"\x05Sales\x18\x01 \x03(\v2\x14.iims.GetSaleMessageR\x05Sales\"#\n" +
+	"\x05Sales\x18\x01 \x03(\v2\x14.iims.GetSaleMessageR\x05Sales\x12$\n" +
+	"\rNextPageToken\x18\x02 \x01(\tR\rNextPageToken\x12\x1e\n" +
+	"\n" +
+	"TotalCount\x18\x03 \x01(\x03R\n" +
+	"TotalCount\"\x84\x02\n" +
+	"\x1aListSalesForProductRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12\x14\n" +
+	"\x05Limit\x18\x02 \x01(\x03R\x05Limit\x12\x16\n" +
+	"\x06Offset\x18\x03 \x01(\x03R\x06Offset\x12\x1c\n" +
+	"\tPageToken\x18\x04 \x01(\tR\tPageToken\x12,\n" +
+	"\x11IncludeTotalCount\x18\x05 \x01(\bR\x11IncludeTotalCount\x12&\n" +
+	"\x0eIncludeDeleted\x18\x06 \x01(\bR\x0eIncludeDeleted\x12&\n" +
+	"\x0eIncludeBlocked\x18\a \x01(\bR\x0eIncludeBlocked\"#\n" +
 	"\x11DeleteSaleRequest\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\tR\x02Id\"u\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"$\n" +
+	"\x12RestoreSaleRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\"\n" +
+	"\x10PurgeSaleRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\xac\x03\n" +
 	"\x11UpdateSaleRequest\x12\x0e\n" +
 	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
 	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
 	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\x1a\n" +
-	"\bSaleSize\x18\x04 \x01(\x05R\bSaleSize\"+\n" +
+	"\bSaleSize\x18\x04 \x01(\x05R\bSaleSize\x126\n" +
+	"\bStartsAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\bStartsAt\x122\n" +
+	"\x06EndsAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x06EndsAt\x126\n" +
+	"\fDiscountType\x18\a \x01(\x0e2\x12.iims.DiscountTypeR\fDiscountType\x12#\n" +
+	"\x06Amount\x18\b \x01(\v2\v.iims.MoneyR\x06Amount\x12 \n" +
+	"\vBuyQuantity\x18\t \x01(\x03R\vBuyQuantity\x12\"\n" +
+	"\fFreeQuantity\x18\n" +
+	" \x01(\x03R\fFreeQuantity\x12&\n" +
+	"\x0eBundleProducts\x18\v \x03(\tR\x0eBundleProducts\"Y\n" +
 	"\x19BlockSaleOperationMessage\x12\x0e\n" +
-	"\x02Id\x18\x01 \x01(\tR\x02Id2\xc5\x04\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x14\n" +
+	"\x05Actor\x18\x02 \x01(\tR\x05Actor\x12\x16\n" +
+	"\x06Reason\x18\x03 \x01(\tR\x06Reason\"j\n" +
+	"\x10PriceLineRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tVariantId\x18\x03 \x01(\tR\tVariantId\"\xb2\x01\n" +
+	"\x15CalculatePriceRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12,\n" +
+	"\x05Lines\x18\x02 \x03(\v2\x16.iims.PriceLineRequestR\x05Lines\x12/\n" +
+	"\x06Policy\x18\x03 \x01(\x0e2\x17.iims.SaleCombinePolicyR\x06Policy\x12\x1c\n" +
+	"\tVariantId\x18\x04 \x01(\tR\tVariantId\"\xbd\x01\n" +
+	"\x12AppliedSaleMessage\x12\x16\n" +
+	"\x06SaleId\x18\x01 \x01(\tR\x06SaleId\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x1a\n" +
+	"\bSaleSize\x18\x03 \x01(\x05R\bSaleSize\x12'\n" +
+	"\bDiscount\x18\x04 \x01(\v2\v.iims.MoneyR\bDiscount\x126\n" +
+	"\fDiscountType\x18\x05 \x01(\x0e2\x12.iims.DiscountTypeR\fDiscountType\"\xa0\x02\n" +
+	"\x11PricedLineMessage\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12)\n" +
+	"\tBasePrice\x18\x03 \x01(\v2\v.iims.MoneyR\tBasePrice\x12.\n" +
+	"\x05Sales\x18\x04 \x03(\v2\x18.iims.AppliedSaleMessageR\x05Sales\x12'\n" +
+	"\bDiscount\x18\x05 \x01(\v2\v.iims.MoneyR\bDiscount\x12)\n" +
+	"\tLineTotal\x18\a \x01(\v2\v.iims.MoneyR\tLineTotal\x12\x1c\n" +
+	"\tVariantId\x18\b \x01(\tR\tVariantIdJ\x04\b\x06\x10\a\"\x9b\x01\n" +
+	"\x16CalculatePriceResponse\x12-\n" +
+	"\x05Lines\x18\x01 \x03(\v2\x17.iims.PricedLineMessageR\x05Lines\x12!\n" +
+	"\x05Total\x18\x02 \x01(\v2\v.iims.MoneyR\x05Total\x12/\n" +
+	"\x06Policy\x18\x03 \x01(\x0e2\x17.iims.SaleCombinePolicyR\x06Policy\"o\n" +
+	"\x0fGetStockRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12 \n" +
+	"\vWarehouseId\x18\x02 \x01(\tR\vWarehouseId\x12\x1c\n" +
+	"\tVariantId\x18\x03 \x01(\tR\tVariantId\"\xcc\x01\n" +
+	"\x12AdjustStockRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12\x14\n" +
+	"\x05Delta\x18\x02 \x01(\x03R\x05Delta\x12 \n" +
+	"\vWarehouseId\x18\x03 \x01(\tR\vWarehouseId\x12,\n" +
+	"\x06Reason\x18\x04 \x01(\x0e2\x14.iims.MovementReasonR\x06Reason\x12\x14\n" +
+	"\x05Actor\x18\x05 \x01(\tR\x05Actor\x12\x1c\n" +
+	"\tVariantId\x18\x06 \x01(\tR\tVariantId\"\x93\x01\n" +
+	"\x17StockReservationRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12 \n" +
+	"\vWarehouseId\x18\x03 \x01(\tR\vWarehouseId\x12\x1c\n" +
+	"\tVariantId\x18\x04 \x01(\tR\tVariantId\"\x9a\x02\n" +
+	"\fStockMessage\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12\x1a\n" +
+	"\bQuantity\x18\x02 \x01(\x03R\bQuantity\x12\x1a\n" +
+	"\bReserved\x18\x03 \x01(\x03R\bReserved\x12\x1c\n" +
+	"\tAvailable\x18\x04 \x01(\x03R\tAvailable\x128\n" +
+	"\tUpdatedAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tUpdatedAt\x12 \n" +
+	"\vWarehouseId\x18\x06 \x01(\tR\vWarehouseId\x12\x1c\n" +
+	"\tInTransit\x18\a \x01(\x03R\tInTransit\x12\x1c\n" +
+	"\tVariantId\x18\b \x01(\tR\tVariantId\"\xfe\x01\n" +
+	"\x14ListMovementsRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12 \n" +
+	"\vWarehouseId\x18\x02 \x01(\tR\vWarehouseId\x12.\n" +
+	"\x04From\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x04From\x12*\n" +
+	"\x02To\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x02To\x12\x14\n" +
+	"\x05Limit\x18\x05 \x01(\x03R\x05Limit\x12\x16\n" +
+	"\x06Offset\x18\x06 \x01(\x03R\x06Offset\x12\x1c\n" +
+	"\tVariantId\x18\a \x01(\tR\tVariantId\"\xb8\x02\n" +
+	"\x14StockMovementMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\tR\tProductId\x12 \n" +
+	"\vWarehouseId\x18\x03 \x01(\tR\vWarehouseId\x12,\n" +
+	"\x06Reason\x18\x04 \x01(\x0e2\x14.iims.MovementReasonR\x06Reason\x12\x14\n" +
+	"\x05Delta\x18\x05 \x01(\x03R\x05Delta\x12\x14\n" +
+	"\x05Actor\x18\x06 \x01(\tR\x05Actor\x12\x1e\n" +
+	"\n" +
+	"TransferId\x18\a \x01(\tR\n" +
+	"TransferId\x128\n" +
+	"\tCreatedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tCreatedAt\x12\x1c\n" +
+	"\tVariantId\x18\t \x01(\tR\tVariantId\"Q\n" +
+	"\x15ListMovementsResponse\x128\n" +
+	"\tMovements\x18\x01 \x03(\v2\x1a.iims.StockMovementMessageR\tMovements\"N\n" +
+	"\x16RebuildBalancesRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12\x16\n" +
+	"\x06DryRun\x18\x02 \x01(\bR\x06DryRun\"\xa9\x01\n" +
+	"\x13BalanceDriftMessage\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12 \n" +
+	"\vWarehouseId\x18\x02 \x01(\tR\vWarehouseId\x12\x1a\n" +
+	"\bRecorded\x18\x03 \x01(\x03R\bRecorded\x12\x18\n" +
+	"\aRebuilt\x18\x04 \x01(\x03R\aRebuilt\x12\x1c\n" +
+	"\tVariantId\x18\x05 \x01(\tR\tVariantId\"f\n" +
+	"\x17RebuildBalancesResponse\x121\n" +
+	"\x06Drifts\x18\x01 \x03(\v2\x19.iims.BalanceDriftMessageR\x06Drifts\x12\x18\n" +
+	"\aApplied\x18\x02 \x01(\bR\aApplied\"F\n" +
+	"\x16InsertWarehouseRequest\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12\x18\n" +
+	"\aAddress\x18\x02 \x01(\tR\aAddress\")\n" +
+	"\x17InsertWarehouseResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"D\n" +
+	"\x14GetWarehousesRequest\x12\x14\n" +
+	"\x05Limit\x18\x01 \x01(\x03R\x05Limit\x12\x16\n" +
+	"\x06Offset\x18\x02 \x01(\x03R\x06Offset\")\n" +
+	"\x17GetByIdWarehouseRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"m\n" +
+	"\x13GetWarehouseMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x18\n" +
+	"\aAddress\x18\x03 \x01(\tR\aAddress\x12\x18\n" +
+	"\aBlocked\x18\x04 \x01(\bR\aBlocked\"R\n" +
+	"\x15GetWarehousesResponse\x129\n" +
+	"\n" +
+	"Warehouses\x18\x01 \x03(\v2\x19.iims.GetWarehouseMessageR\n" +
+	"Warehouses\"(\n" +
+	"\x16DeleteWarehouseRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"V\n" +
+	"\x16UpdateWarehouseRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12\x18\n" +
+	"\aAddress\x18\x03 \x01(\tR\aAddress\"0\n" +
+	"\x1eBlockWarehouseOperationMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\xbf\x01\n" +
+	"\x15CreateTransferRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12(\n" +
+	"\x0fFromWarehouseId\x18\x02 \x01(\tR\x0fFromWarehouseId\x12$\n" +
+	"\rToWarehouseId\x18\x03 \x01(\tR\rToWarehouseId\x12\x1a\n" +
+	"\bQuantity\x18\x04 \x01(\x03R\bQuantity\x12\x1c\n" +
+	"\tVariantId\x18\x05 \x01(\tR\tVariantId\"@\n" +
+	"\x18TransferOperationMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x14\n" +
+	"\x05Actor\x18\x02 \x01(\tR\x05Actor\"\xa7\x03\n" +
+	"\x0fTransferMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x1c\n" +
+	"\tProductId\x18\x02 \x01(\tR\tProductId\x12(\n" +
+	"\x0fFromWarehouseId\x18\x03 \x01(\tR\x0fFromWarehouseId\x12$\n" +
+	"\rToWarehouseId\x18\x04 \x01(\tR\rToWarehouseId\x12\x1a\n" +
+	"\bQuantity\x18\x05 \x01(\x03R\bQuantity\x12,\n" +
+	"\x06Status\x18\x06 \x01(\x0e2\x14.iims.TransferStatusR\x06Status\x128\n" +
+	"\tCreatedAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tCreatedAt\x128\n" +
+	"\tShippedAt\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tShippedAt\x12:\n" +
+	"\n" +
+	"ReceivedAt\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"ReceivedAt\x12\x1c\n" +
+	"\tVariantId\x18\n" +
+	" \x01(\tR\tVariantId\"i\n" +
+	"\x15InsertCategoryRequest\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12 \n" +
+	"\vDescription\x18\x02 \x01(\tR\vDescription\x12\x1a\n" +
+	"\bParentId\x18\x03 \x01(\tR\bParentId\"(\n" +
+	"\x16InsertCategoryResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"D\n" +
+	"\x14GetCategoriesRequest\x12\x14\n" +
+	"\x05Limit\x18\x01 \x01(\x03R\x05Limit\x12\x16\n" +
+	"\x06Offset\x18\x02 \x01(\x03R\x06Offset\"(\n" +
+	"\x16GetByIdCategoryRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\x94\x01\n" +
+	"\x12GetCategoryMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
+	"\vDescription\x18\x03 \x01(\tR\vDescription\x12\x1a\n" +
+	"\bParentId\x18\x04 \x01(\tR\bParentId\x12\x1c\n" +
+	"\tAncestors\x18\x05 \x03(\tR\tAncestors\"Q\n" +
+	"\x15GetCategoriesResponse\x128\n" +
+	"\n" +
+	"Categories\x18\x01 \x03(\v2\x18.iims.GetCategoryMessageR\n" +
+	"Categories\"'\n" +
+	"\x15DeleteCategoryRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"]\n" +
+	"\x15UpdateCategoryRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12 \n" +
+	"\vDescription\x18\x03 \x01(\tR\vDescription\"0\n" +
+	"\x16GetCategoryTreeRequest\x12\x16\n" +
+	"\x06RootId\x18\x01 \x01(\tR\x06RootId\"|\n" +
+	"\x10CategoryTreeNode\x124\n" +
+	"\bCategory\x18\x01 \x01(\v2\x18.iims.GetCategoryMessageR\bCategory\x122\n" +
+	"\bChildren\x18\x02 \x03(\v2\x16.iims.CategoryTreeNodeR\bChildren\"G\n" +
+	"\x17GetCategoryTreeResponse\x12,\n" +
+	"\x05Roots\x18\x01 \x03(\v2\x16.iims.CategoryTreeNodeR\x05Roots\"A\n" +
+	"\x13MoveCategoryRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x1a\n" +
+	"\bParentId\x18\x02 \x01(\tR\bParentId\"\xb7\x01\n" +
+	"\x16InsertAttributeRequest\x12\x1e\n" +
+	"\n" +
+	"CategoryId\x18\x01 \x01(\tR\n" +
+	"CategoryId\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12'\n" +
+	"\x04Type\x18\x03 \x01(\x0e2\x13.iims.AttributeTypeR\x04Type\x12\x1a\n" +
+	"\bRequired\x18\x04 \x01(\bR\bRequired\x12$\n" +
+	"\rAllowedValues\x18\x05 \x03(\tR\rAllowedValues\")\n" +
+	"\x17InsertAttributeResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"6\n" +
+	"\x14GetAttributesRequest\x12\x1e\n" +
+	"\n" +
+	"CategoryId\x18\x01 \x01(\tR\n" +
+	"CategoryId\"\xc4\x01\n" +
+	"\x13GetAttributeMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x1e\n" +
+	"\n" +
+	"CategoryId\x18\x02 \x01(\tR\n" +
+	"CategoryId\x12\x12\n" +
+	"\x04Name\x18\x03 \x01(\tR\x04Name\x12'\n" +
+	"\x04Type\x18\x04 \x01(\x0e2\x13.iims.AttributeTypeR\x04Type\x12\x1a\n" +
+	"\bRequired\x18\x05 \x01(\bR\bRequired\x12$\n" +
+	"\rAllowedValues\x18\x06 \x03(\tR\rAllowedValues\"R\n" +
+	"\x15GetAttributesResponse\x129\n" +
+	"\n" +
+	"Attributes\x18\x01 \x03(\v2\x19.iims.GetAttributeMessageR\n" +
+	"Attributes\"j\n" +
+	"\x16UpdateAttributeRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x1a\n" +
+	"\bRequired\x18\x02 \x01(\bR\bRequired\x12$\n" +
+	"\rAllowedValues\x18\x03 \x03(\tR\rAllowedValues\"(\n" +
+	"\x16DeleteAttributeRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"W\n" +
+	"\x10PriceTierMessage\x12 \n" +
+	"\vMinQuantity\x18\x01 \x01(\x03R\vMinQuantity\x12!\n" +
+	"\x05Price\x18\x02 \x01(\v2\v.iims.MoneyR\x05Price\"b\n" +
+	"\x14PriceListItemMessage\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12,\n" +
+	"\x05Tiers\x18\x02 \x03(\v2\x16.iims.PriceTierMessageR\x05Tiers\"\x98\x02\n" +
+	"\x16InsertPriceListRequest\x12\x12\n" +
+	"\x04Name\x18\x01 \x01(\tR\x04Name\x12,\n" +
+	"\aChannel\x18\x02 \x01(\x0e2\x12.iims.SalesChannelR\aChannel\x12\x1a\n" +
+	"\bPriority\x18\x03 \x01(\x05R\bPriority\x128\n" +
+	"\tValidFrom\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tValidFrom\x124\n" +
+	"\aValidTo\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aValidTo\x120\n" +
+	"\x05Items\x18\x06 \x03(\v2\x1a.iims.PriceListItemMessageR\x05Items\")\n" +
+	"\x17InsertPriceListResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"r\n" +
+	"\x14GetPriceListsRequest\x12\x14\n" +
+	"\x05Limit\x18\x01 \x01(\x03R\x05Limit\x12\x16\n" +
+	"\x06Offset\x18\x02 \x01(\x03R\x06Offset\x12,\n" +
+	"\aChannel\x18\x03 \x01(\x0e2\x12.iims.SalesChannelR\aChannel\")\n" +
+	"\x17GetByIdPriceListRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\xa5\x02\n" +
+	"\x13GetPriceListMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12,\n" +
+	"\aChannel\x18\x03 \x01(\x0e2\x12.iims.SalesChannelR\aChannel\x12\x1a\n" +
+	"\bPriority\x18\x04 \x01(\x05R\bPriority\x128\n" +
+	"\tValidFrom\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tValidFrom\x124\n" +
+	"\aValidTo\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aValidTo\x120\n" +
+	"\x05Items\x18\a \x03(\v2\x1a.iims.PriceListItemMessageR\x05Items\"R\n" +
+	"\x15GetPriceListsResponse\x129\n" +
+	"\n" +
+	"PriceLists\x18\x01 \x03(\v2\x19.iims.GetPriceListMessageR\n" +
+	"PriceLists\"\xa8\x02\n" +
+	"\x16UpdatePriceListRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
+	"\x04Name\x18\x02 \x01(\tR\x04Name\x12,\n" +
+	"\aChannel\x18\x03 \x01(\x0e2\x12.iims.SalesChannelR\aChannel\x12\x1a\n" +
+	"\bPriority\x18\x04 \x01(\x05R\bPriority\x128\n" +
+	"\tValidFrom\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tValidFrom\x124\n" +
+	"\aValidTo\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aValidTo\x120\n" +
+	"\x05Items\x18\a \x03(\v2\x1a.iims.PriceListItemMessageR\x05Items\"(\n" +
+	"\x16DeletePriceListRequest\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"}\n" +
+	"\x13ResolvePriceRequest\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12,\n" +
+	"\aChannel\x18\x02 \x01(\x0e2\x12.iims.SalesChannelR\aChannel\x12\x1a\n" +
+	"\bQuantity\x18\x03 \x01(\x03R\bQuantity\"\xc6\x01\n" +
+	"\x14ResolvePriceResponse\x12\x1c\n" +
+	"\tProductId\x18\x01 \x01(\tR\tProductId\x12)\n" +
+	"\tUnitPrice\x18\x02 \x01(\v2\v.iims.MoneyR\tUnitPrice\x12!\n" +
+	"\x05Total\x18\x03 \x01(\v2\v.iims.MoneyR\x05Total\x12 \n" +
+	"\vPriceListId\x18\x04 \x01(\tR\vPriceListId\x12 \n" +
+	"\vMinQuantity\x18\x05 \x01(\x03R\vMinQuantity\"\xcb\x01\n" +
+	"\x13InsertCouponRequest\x12\x12\n" +
+	"\x04Code\x18\x01 \x01(\tR\x04Code\x12\x16\n" +
+	"\x06SaleId\x18\x02 \x01(\tR\x06SaleId\x12&\n" +
+	"\x0eMaxRedemptions\x18\x03 \x01(\x03R\x0eMaxRedemptions\x12&\n" +
+	"\x0eMaxPerCustomer\x18\x04 \x01(\x03R\x0eMaxPerCustomer\x128\n" +
+	"\tExpiresAt\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tExpiresAt\"&\n" +
+	"\x14InsertCouponResponse\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\"\x80\x02\n" +
+	"\x16GenerateCouponsRequest\x12\x16\n" +
+	"\x06SaleId\x18\x01 \x01(\tR\x06SaleId\x12\x14\n" +
+	"\x05Count\x18\x02 \x01(\x05R\x05Count\x12\x16\n" +
+	"\x06Prefix\x18\x03 \x01(\tR\x06Prefix\x12\x16\n" +
+	"\x06Length\x18\x04 \x01(\x05R\x06Length\x12&\n" +
+	"\x0eMaxRedemptions\x18\x05 \x01(\x03R\x0eMaxRedemptions\x12&\n" +
+	"\x0eMaxPerCustomer\x18\x06 \x01(\x03R\x0eMaxPerCustomer\x128\n" +
+	"\tExpiresAt\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tExpiresAt\"/\n" +
+	"\x17GenerateCouponsResponse\x12\x14\n" +
+	"\x05Codes\x18\x01 \x03(\tR\x05Codes\"G\n" +
+	"\x11CouponCodeRequest\x12\x12\n" +
+	"\x04Code\x18\x01 \x01(\tR\x04Code\x12\x1e\n" +
+	"\n" +
+	"CustomerId\x18\x02 \x01(\tR\n" +
+	"CustomerId\"\xf7\x01\n" +
+	"\rCouponMessage\x12\x0e\n" +
+	"\x02Id\x18\x01 \x01(\tR\x02Id\x12\x12\n" +
+	"\x04Code\x18\x02 \x01(\tR\x04Code\x12\x16\n" +
+	"\x06SaleId\x18\x03 \x01(\tR\x06SaleId\x12&\n" +
+	"\x0eMaxRedemptions\x18\x04 \x01(\x03R\x0eMaxRedemptions\x12&\n" +
+	"\x0eMaxPerCustomer\x18\x05 \x01(\x03R\x0eMaxPerCustomer\x128\n" +
+	"\tExpiresAt\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tExpiresAt\x12 \n" +
+	"\vRedemptions\x18\a \x01(\x03R\vRedemptions\"\x90\x01\n" +
+	"\x16ValidateCouponResponse\x12\x14\n" +
+	"\x05Valid\x18\x01 \x01(\bR\x05Valid\x123\n" +
+	"\tRejection\x18\x02 \x01(\x0e2\x15.iims.CouponRejectionR\tRejection\x12+\n" +
+	"\x06Coupon\x18\x03 \x01(\v2\x13.iims.CouponMessageR\x06Coupon\"C\n" +
+	"\x14RedeemCouponResponse\x12+\n" +
+	"\x06Coupon\x18\x01 \x01(\v2\x13.iims.CouponMessageR\x06Coupon*\x97\x01\n" +
+	"\x10ProductSortField\x12\"\n" +
+	"\x1ePRODUCT_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PRODUCT_SORT_FIELD_NAME\x10\x01\x12\x1c\n" +
+	"\x18PRODUCT_SORT_FIELD_PRICE\x10\x02\x12$\n" +
+	" PRODUCT_SORT_FIELD_CREATION_DATE\x10\x03*@\n" +
+	"\rSortDirection\x12\x16\n" +
+	"\x12SORT_DIRECTION_ASC\x10\x00\x12\x17\n" +
+	"\x13SORT_DIRECTION_DESC\x10\x01*\x9c\x01\n" +
+	"\x11PriceChangeStatus\x12#\n" +
+	"\x1fPRICE_CHANGE_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dPRICE_CHANGE_STATUS_SCHEDULED\x10\x01\x12\x1f\n" +
+	"\x1bPRICE_CHANGE_STATUS_APPLIED\x10\x02\x12\x1e\n" +
+	"\x1aPRICE_CHANGE_STATUS_FAILED\x10\x03*u\n" +
+	"\n" +
+	"SaleStatus\x12\x1b\n" +
+	"\x17SALE_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15SALE_STATUS_SCHEDULED\x10\x01\x12\x16\n" +
+	"\x12SALE_STATUS_ACTIVE\x10\x02\x12\x17\n" +
+	"\x13SALE_STATUS_EXPIRED\x10\x03*\xc3\x01\n" +
+	"\fDiscountType\x12\x1d\n" +
+	"\x19DISCOUNT_TYPE_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DISCOUNT_TYPE_PERCENTAGE\x10\x01\x12\x1e\n" +
+	"\x1aDISCOUNT_TYPE_FIXED_AMOUNT\x10\x02\x12\x1d\n" +
+	"\x19DISCOUNT_TYPE_FIXED_PRICE\x10\x03\x12\x1d\n" +
+	"\x19DISCOUNT_TYPE_BUY_X_GET_Y\x10\x04\x12\x18\n" +
+	"\x14DISCOUNT_TYPE_BUNDLE\x10\x05*t\n" +
+	"\n" +
+	"SaleTarget\x12\x1b\n" +
+	"\x17SALE_TARGET_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SALE_TARGET_PRODUCTS\x10\x01\x12\x1a\n" +
+	"\x16SALE_TARGET_CATEGORIES\x10\x02\x12\x13\n" +
+	"\x0fSALE_TARGET_ALL\x10\x03*\x9b\x01\n" +
+	"\x11SaleCombinePolicy\x12#\n" +
+	"\x1fSALE_COMBINE_POLICY_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bSALE_COMBINE_POLICY_BEST_OF\x10\x01\x12\x1d\n" +
+	"\x19SALE_COMBINE_POLICY_STACK\x10\x02\x12!\n" +
+	"\x1dSALE_COMBINE_POLICY_EXCLUSIVE\x10\x03*\xea\x01\n" +
+	"\x0eMovementReason\x12\x1f\n" +
+	"\x1bMOVEMENT_REASON_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17MOVEMENT_REASON_RECEIPT\x10\x01\x12\x18\n" +
+	"\x14MOVEMENT_REASON_SALE\x10\x02\x12\x1e\n" +
+	"\x1aMOVEMENT_REASON_ADJUSTMENT\x10\x03\x12\x1c\n" +
+	"\x18MOVEMENT_REASON_TRANSFER\x10\x04\x12\x1d\n" +
+	"\x19MOVEMENT_REASON_WRITE_OFF\x10\x05\x12#\n" +
+	"\x1fMOVEMENT_REASON_OPENING_BALANCE\x10\x06*\x89\x01\n" +
+	"\x0eTransferStatus\x12\x1f\n" +
+	"\x1bTRANSFER_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17TRANSFER_STATUS_CREATED\x10\x01\x12\x1b\n" +
+	"\x17TRANSFER_STATUS_SHIPPED\x10\x02\x12\x1c\n" +
+	"\x18TRANSFER_STATUS_RECEIVED\x10\x03*\x97\x01\n" +
+	"\rAttributeType\x12\x1e\n" +
+	"\x1aATTRIBUTE_TYPE_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_STRING\x10\x01\x12\x19\n" +
+	"\x15ATTRIBUTE_TYPE_NUMBER\x10\x02\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_BOOL\x10\x03\x12\x17\n" +
+	"\x13ATTRIBUTE_TYPE_ENUM\x10\x04*~\n" +
+	"\fSalesChannel\x12\x1d\n" +
+	"\x19SALES_CHANNEL_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14SALES_CHANNEL_RETAIL\x10\x01\x12\x1b\n" +
+	"\x17SALES_CHANNEL_WHOLESALE\x10\x02\x12\x18\n" +
+	"\x14SALES_CHANNEL_ONLINE\x10\x03*\xba\x01\n" +
+	"\x0fCouponRejection\x12 \n" +
+	"\x1cCOUPON_REJECTION_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18COUPON_REJECTION_EXPIRED\x10\x01\x12\x1e\n" +
+	"\x1aCOUPON_REJECTION_EXHAUSTED\x10\x02\x12#\n" +
+	"\x1fCOUPON_REJECTION_CUSTOMER_LIMIT\x10\x03\x12\"\n" +
+	"\x1eCOUPON_REJECTION_SALE_INACTIVE\x10\x042\xa1\n" +
+	"\n" +
 	"\x0eProductService\x12F\n" +
 	"\tInsertOne\x12\x1a.iims.InsertProductRequest\x1a\x1b.iims.InsertProductResponse\"\x00\x12<\n" +
 	"\x03Get\x12\x18.iims.GetProductsRequest\x1a\x19.iims.GetProductsResponse\"\x00\x12A\n" +
 	"\aGetById\x12\x1b.iims.GetByIdProductRequest\x1a\x17.iims.GetProductMessage\"\x00\x12L\n" +
-	"\x10GetByProductCode\x12\x1d.iims.GetByProductCodeRequest\x1a\x17.iims.GetProductMessage\"\x00\x12>\n" +
-	"\x06Delete\x12\x1a.iims.DeleteProductRequest\x1a\x16.google.protobuf.Empty\"\x00\x12>\n" +
+	"\x10GetByProductCode\x12\x1d.iims.GetByProductCodeRequest\x1a\x17.iims.GetProductMessage\"\x00\x12D\n" +
+	"\fGetByBarcode\x12\x19.iims.GetByBarcodeRequest\x1a\x17.iims.GetProductMessage\"\x00\x12M\n" +
+	"\x0eSearchProducts\x12\x1b.iims.SearchProductsRequest\x1a\x1c.iims.SearchProductsResponse\"\x00\x12>\n" +
+	"\x06Delete\x12\x1a.iims.DeleteProductRequest\x1a\x16.google.protobuf.Empty\"\x00\x12@\n" +
+	"\aRestore\x12\x1b.iims.RestoreProductRequest\x1a\x16.google.protobuf.Empty\"\x00\x12<\n" +
+	"\x05Purge\x12\x19.iims.PurgeProductRequest\x1a\x16.google.protobuf.Empty\"\x00\x12>\n" +
 	"\x06Update\x12\x1a.iims.UpdateProductRequest\x1a\x16.google.protobuf.Empty\"\x00\x12L\n" +
 	"\fBlockProduct\x12\".iims.BlockProductOperationMessage\x1a\x16.google.protobuf.Empty\"\x00\x12N\n" +
-	"\x0eUnblockProduct\x12\".iims.BlockProductOperationMessage\x1a\x16.google.protobuf.Empty\"\x002\x93\x03\n" +
+	"\x0eUnblockProduct\x12\".iims.BlockProductOperationMessage\x1a\x16.google.protobuf.Empty\"\x00\x12S\n" +
+	"\x0fGetAvailability\x12\x1c.iims.GetAvailabilityRequest\x1a .iims.ProductAvailabilityMessage\"\x00\x12J\n" +
+	"\rInsertVariant\x12\x1a.iims.InsertVariantRequest\x1a\x1b.iims.InsertVariantResponse\"\x00\x12E\n" +
+	"\rUpdateVariant\x12\x1a.iims.UpdateVariantRequest\x1a\x16.google.protobuf.Empty\"\x00\x12E\n" +
+	"\rDeleteVariant\x12\x1a.iims.DeleteVariantRequest\x1a\x16.google.protobuf.Empty\"\x00\x12S\n" +
+	"\x13SchedulePriceChange\x12 .iims.SchedulePriceChangeRequest\x1a\x18.iims.PriceChangeMessage\"\x00\x12A\n" +
+	"\n" +
+	"GetPriceAt\x12\x17.iims.GetPriceAtRequest\x1a\x18.iims.PriceChangeMessage\"\x002\xaf\x05\n" +
 	"\vSaleService\x12@\n" +
 	"\tInsertOne\x12\x17.iims.InsertSaleRequest\x1a\x18.iims.InsertSaleResponse\"\x00\x126\n" +
 	"\x03Get\x12\x15.iims.GetSalesRequest\x1a\x16.iims.GetSalesResponse\"\x00\x12;\n" +
-	"\x06Delete\x12\x17.iims.DeleteSaleRequest\x1a\x16.google.protobuf.Empty\"\x00\x12;\n" +
+	"\x06Delete\x12\x17.iims.DeleteSaleRequest\x1a\x16.google.protobuf.Empty\"\x00\x12=\n" +
+	"\aRestore\x12\x18.iims.RestoreSaleRequest\x1a\x16.google.protobuf.Empty\"\x00\x129\n" +
+	"\x05Purge\x12\x16.iims.PurgeSaleRequest\x1a\x16.google.protobuf.Empty\"\x00\x12;\n" +
 	"\x06Update\x12\x17.iims.UpdateSaleRequest\x1a\x16.google.protobuf.Empty\"\x00\x12F\n" +
 	"\tBlockSale\x12\x1f.iims.BlockSaleOperationMessage\x1a\x16.google.protobuf.Empty\"\x00\x12H\n" +
-	"\vUnblockSale\x12\x1f.iims.BlockSaleOperationMessage\x1a\x16.google.protobuf.Empty\"\x00B(Z&github.com/igntnk/stocky_iims/proto/pbb\x06proto3"
+	"\vUnblockSale\x12\x1f.iims.BlockSaleOperationMessage\x1a\x16.google.protobuf.Empty\"\x00\x12M\n" +
+	"\x0eCalculatePrice\x12\x1b.iims.CalculatePriceRequest\x1a\x1c.iims.CalculatePriceResponse\"\x00\x12Q\n" +
+	"\x13ListSalesForProduct\x12 .iims.ListSalesForProductRequest\x1a\x16.iims.GetSalesResponse\"\x002\xa4\x03\n" +
+	"\fStockService\x127\n" +
+	"\bGetStock\x12\x15.iims.GetStockRequest\x1a\x12.iims.StockMessage\"\x00\x12=\n" +
+	"\vAdjustStock\x12\x18.iims.AdjustStockRequest\x1a\x12.iims.StockMessage\"\x00\x12>\n" +
+	"\aReserve\x12\x1d.iims.StockReservationRequest\x1a\x12.iims.StockMessage\"\x00\x12>\n" +
+	"\aRelease\x12\x1d.iims.StockReservationRequest\x1a\x12.iims.StockMessage\"\x00\x12J\n" +
+	"\rListMovements\x12\x1a.iims.ListMovementsRequest\x1a\x1b.iims.ListMovementsResponse\"\x00\x12P\n" +
+	"\x0fRebuildBalances\x12\x1c.iims.RebuildBalancesRequest\x1a\x1d.iims.RebuildBalancesResponse\"\x002\x91\x04\n" +
+	"\x10WarehouseService\x12J\n" +
+	"\tInsertOne\x12\x1c.iims.InsertWarehouseRequest\x1a\x1d.iims.InsertWarehouseResponse\"\x00\x12@\n" +
+	"\x03Get\x12\x1a.iims.GetWarehousesRequest\x1a\x1b.iims.GetWarehousesResponse\"\x00\x12E\n" +
+	"\aGetById\x12\x1d.iims.GetByIdWarehouseRequest\x1a\x19.iims.GetWarehouseMessage\"\x00\x12@\n" +
+	"\x06Delete\x12\x1c.iims.DeleteWarehouseRequest\x1a\x16.google.protobuf.Empty\"\x00\x12@\n" +
+	"\x06Update\x12\x1c.iims.UpdateWarehouseRequest\x1a\x16.google.protobuf.Empty\"\x00\x12P\n" +
+	"\x0eBlockWarehouse\x12$.iims.BlockWarehouseOperationMessage\x1a\x16.google.protobuf.Empty\"\x00\x12R\n" +
+	"\x10UnblockWarehouse\x12$.iims.BlockWarehouseOperationMessage\x1a\x16.google.protobuf.Empty\"\x002\xee\x01\n" +
+	"\x0fTransferService\x12F\n" +
+	"\x0eCreateTransfer\x12\x1b.iims.CreateTransferRequest\x1a\x15.iims.TransferMessage\"\x00\x12G\n" +
+	"\fShipTransfer\x12\x1e.iims.TransferOperationMessage\x1a\x15.iims.TransferMessage\"\x00\x12J\n" +
+	"\x0fReceiveTransfer\x12\x1e.iims.TransferOperationMessage\x1a\x15.iims.TransferMessage\"\x002\xf3\x03\n" +
+	"\x0fCategoryService\x12H\n" +
+	"\tInsertOne\x12\x1b.iims.InsertCategoryRequest\x1a\x1c.iims.InsertCategoryResponse\"\x00\x12@\n" +
+	"\x03Get\x12\x1a.iims.GetCategoriesRequest\x1a\x1b.iims.GetCategoriesResponse\"\x00\x12C\n" +
+	"\aGetById\x12\x1c.iims.GetByIdCategoryRequest\x1a\x18.iims.GetCategoryMessage\"\x00\x12?\n" +
+	"\x06Delete\x12\x1b.iims.DeleteCategoryRequest\x1a\x16.google.protobuf.Empty\"\x00\x12?\n" +
+	"\x06Update\x12\x1b.iims.UpdateCategoryRequest\x1a\x16.google.protobuf.Empty\"\x00\x12H\n" +
+	"\aGetTree\x12\x1c.iims.GetCategoryTreeRequest\x1a\x1d.iims.GetCategoryTreeResponse\"\x00\x12C\n" +
+	"\fMoveCategory\x12\x19.iims.MoveCategoryRequest\x1a\x16.google.protobuf.Empty\"\x002\xa4\x02\n" +
+	"\x10AttributeService\x12J\n" +
+	"\tInsertOne\x12\x1c.iims.InsertAttributeRequest\x1a\x1d.iims.InsertAttributeResponse\"\x00\x12@\n" +
+	"\x03Get\x12\x1a.iims.GetAttributesRequest\x1a\x1b.iims.GetAttributesResponse\"\x00\x12@\n" +
+	"\x06Update\x12\x1c.iims.UpdateAttributeRequest\x1a\x16.google.protobuf.Empty\"\x00\x12@\n" +
+	"\x06Delete\x12\x1c.iims.DeleteAttributeRequest\x1a\x16.google.protobuf.Empty\"\x002\xb4\x03\n" +
+	"\x10PriceListService\x12J\n" +
+	"\tInsertOne\x12\x1c.iims.InsertPriceListRequest\x1a\x1d.iims.InsertPriceListResponse\"\x00\x12@\n" +
+	"\x03Get\x12\x1a.iims.GetPriceListsRequest\x1a\x1b.iims.GetPriceListsResponse\"\x00\x12E\n" +
+	"\aGetById\x12\x1d.iims.GetByIdPriceListRequest\x1a\x19.iims.GetPriceListMessage\"\x00\x12@\n" +
+	"\x06Update\x12\x1c.iims.UpdatePriceListRequest\x1a\x16.google.protobuf.Empty\"\x00\x12@\n" +
+	"\x06Delete\x12\x1c.iims.DeletePriceListRequest\x1a\x16.google.protobuf.Empty\"\x00\x12G\n" +
+	"\fResolvePrice\x12\x19.iims.ResolvePriceRequest\x1a\x1a.iims.ResolvePriceResponse\"\x002\xb2\x02\n" +
+	"\rCouponService\x12D\n" +
+	"\tInsertOne\x12\x19.iims.InsertCouponRequest\x1a\x1a.iims.InsertCouponResponse\"\x00\x12I\n" +
+	"\bGenerate\x12\x1c.iims.GenerateCouponsRequest\x1a\x1d.iims.GenerateCouponsResponse\"\x00\x12I\n" +
+	"\x0eValidateCoupon\x12\x17.iims.CouponCodeRequest\x1a\x1c.iims.ValidateCouponResponse\"\x00\x12E\n" +
+	"\fRedeemCoupon\x12\x17.iims.CouponCodeRequest\x1a\x1a.iims.RedeemCouponResponse\"\x00B(Z&github.com/igntnk/stocky_iims/proto/pbb\x06proto3"
 
 var (
 	file_iims_proto_rawDescOnce sync.Once
@@ -1092,64 +8457,381 @@ func file_iims_proto_rawDescGZIP() []byte {
 	return file_iims_proto_rawDescData
 }
 
-var file_iims_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
+var file_iims_proto_enumTypes = make([]protoimpl.EnumInfo, 12)
+var file_iims_proto_msgTypes = make([]protoimpl.MessageInfo, 112)
 var file_iims_proto_goTypes = []any{
-	(*InsertProductRequest)(nil),         // 0: iims.InsertProductRequest
-	(*GetByProductCodeRequest)(nil),      // 1: iims.GetByProductCodeRequest
-	(*GetByIdProductRequest)(nil),        // 2: iims.GetByIdProductRequest
-	(*InsertProductResponse)(nil),        // 3: iims.InsertProductResponse
-	(*GetProductsRequest)(nil),           // 4: iims.GetProductsRequest
-	(*GetProductMessage)(nil),            // 5: iims.GetProductMessage
-	(*GetProductsResponse)(nil),          // 6: iims.GetProductsResponse
-	(*DeleteProductRequest)(nil),         // 7: iims.DeleteProductRequest
-	(*UpdateProductRequest)(nil),         // 8: iims.UpdateProductRequest
-	(*BlockProductOperationMessage)(nil), // 9: iims.BlockProductOperationMessage
-	(*InsertSaleRequest)(nil),            // 10: iims.InsertSaleRequest
-	(*InsertSaleResponse)(nil),           // 11: iims.InsertSaleResponse
-	(*GetSalesRequest)(nil),              // 12: iims.GetSalesRequest
-	(*GetSaleMessage)(nil),               // 13: iims.GetSaleMessage
-	(*GetSalesResponse)(nil),             // 14: iims.GetSalesResponse
-	(*DeleteSaleRequest)(nil),            // 15: iims.DeleteSaleRequest
-	(*UpdateSaleRequest)(nil),            // 16: iims.UpdateSaleRequest
-	(*BlockSaleOperationMessage)(nil),    // 17: iims.BlockSaleOperationMessage
-	(*emptypb.Empty)(nil),                // 18: google.protobuf.Empty
+	(ProductSortField)(0),                  // 0: iims.ProductSortField
+	(SortDirection)(0),                     // 1: iims.SortDirection
+	(PriceChangeStatus)(0),                 // 2: iims.PriceChangeStatus
+	(SaleStatus)(0),                        // 3: iims.SaleStatus
+	(DiscountType)(0),                      // 4: iims.DiscountType
+	(SaleTarget)(0),                        // 5: iims.SaleTarget
+	(SaleCombinePolicy)(0),                 // 6: iims.SaleCombinePolicy
+	(MovementReason)(0),                    // 7: iims.MovementReason
+	(TransferStatus)(0),                    // 8: iims.TransferStatus
+	(AttributeType)(0),                     // 9: iims.AttributeType
+	(SalesChannel)(0),                      // 10: iims.SalesChannel
+	(CouponRejection)(0),                   // 11: iims.CouponRejection
+	(*Money)(nil),                          // 12: iims.Money
+	(*InsertProductRequest)(nil),           // 13: iims.InsertProductRequest
+	(*GetByProductCodeRequest)(nil),        // 14: iims.GetByProductCodeRequest
+	(*GetByBarcodeRequest)(nil),            // 15: iims.GetByBarcodeRequest
+	(*SearchProductsRequest)(nil),          // 16: iims.SearchProductsRequest
+	(*ScoredProductMessage)(nil),           // 17: iims.ScoredProductMessage
+	(*SearchProductsResponse)(nil),         // 18: iims.SearchProductsResponse
+	(*GetByIdProductRequest)(nil),          // 19: iims.GetByIdProductRequest
+	(*InsertProductResponse)(nil),          // 20: iims.InsertProductResponse
+	(*GetProductsRequest)(nil),             // 21: iims.GetProductsRequest
+	(*GetProductMessage)(nil),              // 22: iims.GetProductMessage
+	(*VariantMessage)(nil),                 // 23: iims.VariantMessage
+	(*InsertVariantRequest)(nil),           // 24: iims.InsertVariantRequest
+	(*InsertVariantResponse)(nil),          // 25: iims.InsertVariantResponse
+	(*UpdateVariantRequest)(nil),           // 26: iims.UpdateVariantRequest
+	(*DeleteVariantRequest)(nil),           // 27: iims.DeleteVariantRequest
+	(*SchedulePriceChangeRequest)(nil),     // 28: iims.SchedulePriceChangeRequest
+	(*GetPriceAtRequest)(nil),              // 29: iims.GetPriceAtRequest
+	(*PriceChangeMessage)(nil),             // 30: iims.PriceChangeMessage
+	(*GetProductsResponse)(nil),            // 31: iims.GetProductsResponse
+	(*DeleteProductRequest)(nil),           // 32: iims.DeleteProductRequest
+	(*RestoreProductRequest)(nil),          // 33: iims.RestoreProductRequest
+	(*PurgeProductRequest)(nil),            // 34: iims.PurgeProductRequest
+	(*UpdateProductRequest)(nil),           // 35: iims.UpdateProductRequest
+	(*BlockProductOperationMessage)(nil),   // 36: iims.BlockProductOperationMessage
+	(*GetAvailabilityRequest)(nil),         // 37: iims.GetAvailabilityRequest
+	(*WarehouseAvailabilityMessage)(nil),   // 38: iims.WarehouseAvailabilityMessage
+	(*ProductAvailabilityMessage)(nil),     // 39: iims.ProductAvailabilityMessage
+	(*InsertSaleRequest)(nil),              // 40: iims.InsertSaleRequest
+	(*InsertSaleResponse)(nil),             // 41: iims.InsertSaleResponse
+	(*GetSalesRequest)(nil),                // 42: iims.GetSalesRequest
+	(*GetSaleMessage)(nil),                 // 43: iims.GetSaleMessage
+	(*GetSalesResponse)(nil),               // 44: iims.GetSalesResponse
+	(*ListSalesForProductRequest)(nil),     // 45: iims.ListSalesForProductRequest
+	(*DeleteSaleRequest)(nil),              // 46: iims.DeleteSaleRequest
+	(*RestoreSaleRequest)(nil),             // 47: iims.RestoreSaleRequest
+	(*PurgeSaleRequest)(nil),               // 48: iims.PurgeSaleRequest
+	(*UpdateSaleRequest)(nil),              // 49: iims.UpdateSaleRequest
+	(*BlockSaleOperationMessage)(nil),      // 50: iims.BlockSaleOperationMessage
+	(*PriceLineRequest)(nil),               // 51: iims.PriceLineRequest
+	(*CalculatePriceRequest)(nil),          // 52: iims.CalculatePriceRequest
+	(*AppliedSaleMessage)(nil),             // 53: iims.AppliedSaleMessage
+	(*PricedLineMessage)(nil),              // 54: iims.PricedLineMessage
+	(*CalculatePriceResponse)(nil),         // 55: iims.CalculatePriceResponse
+	(*GetStockRequest)(nil),                // 56: iims.GetStockRequest
+	(*AdjustStockRequest)(nil),             // 57: iims.AdjustStockRequest
+	(*StockReservationRequest)(nil),        // 58: iims.StockReservationRequest
+	(*StockMessage)(nil),                   // 59: iims.StockMessage
+	(*ListMovementsRequest)(nil),           // 60: iims.ListMovementsRequest
+	(*StockMovementMessage)(nil),           // 61: iims.StockMovementMessage
+	(*ListMovementsResponse)(nil),          // 62: iims.ListMovementsResponse
+	(*RebuildBalancesRequest)(nil),         // 63: iims.RebuildBalancesRequest
+	(*BalanceDriftMessage)(nil),            // 64: iims.BalanceDriftMessage
+	(*RebuildBalancesResponse)(nil),        // 65: iims.RebuildBalancesResponse
+	(*InsertWarehouseRequest)(nil),         // 66: iims.InsertWarehouseRequest
+	(*InsertWarehouseResponse)(nil),        // 67: iims.InsertWarehouseResponse
+	(*GetWarehousesRequest)(nil),           // 68: iims.GetWarehousesRequest
+	(*GetByIdWarehouseRequest)(nil),        // 69: iims.GetByIdWarehouseRequest
+	(*GetWarehouseMessage)(nil),            // 70: iims.GetWarehouseMessage
+	(*GetWarehousesResponse)(nil),          // 71: iims.GetWarehousesResponse
+	(*DeleteWarehouseRequest)(nil),         // 72: iims.DeleteWarehouseRequest
+	(*UpdateWarehouseRequest)(nil),         // 73: iims.UpdateWarehouseRequest
+	(*BlockWarehouseOperationMessage)(nil), // 74: iims.BlockWarehouseOperationMessage
+	(*CreateTransferRequest)(nil),          // 75: iims.CreateTransferRequest
+	(*TransferOperationMessage)(nil),       // 76: iims.TransferOperationMessage
+	(*TransferMessage)(nil),                // 77: iims.TransferMessage
+	(*InsertCategoryRequest)(nil),          // 78: iims.InsertCategoryRequest
+	(*InsertCategoryResponse)(nil),         // 79: iims.InsertCategoryResponse
+	(*GetCategoriesRequest)(nil),           // 80: iims.GetCategoriesRequest
+	(*GetByIdCategoryRequest)(nil),         // 81: iims.GetByIdCategoryRequest
+	(*GetCategoryMessage)(nil),             // 82: iims.GetCategoryMessage
+	(*GetCategoriesResponse)(nil),          // 83: iims.GetCategoriesResponse
+	(*DeleteCategoryRequest)(nil),          // 84: iims.DeleteCategoryRequest
+	(*UpdateCategoryRequest)(nil),          // 85: iims.UpdateCategoryRequest
+	(*GetCategoryTreeRequest)(nil),         // 86: iims.GetCategoryTreeRequest
+	(*CategoryTreeNode)(nil),               // 87: iims.CategoryTreeNode
+	(*GetCategoryTreeResponse)(nil),        // 88: iims.GetCategoryTreeResponse
+	(*MoveCategoryRequest)(nil),            // 89: iims.MoveCategoryRequest
+	(*InsertAttributeRequest)(nil),         // 90: iims.InsertAttributeRequest
+	(*InsertAttributeResponse)(nil),        // 91: iims.InsertAttributeResponse
+	(*GetAttributesRequest)(nil),           // 92: iims.GetAttributesRequest
+	(*GetAttributeMessage)(nil),            // 93: iims.GetAttributeMessage
+	(*GetAttributesResponse)(nil),          // 94: iims.GetAttributesResponse
+	(*UpdateAttributeRequest)(nil),         // 95: iims.UpdateAttributeRequest
+	(*DeleteAttributeRequest)(nil),         // 96: iims.DeleteAttributeRequest
+	(*PriceTierMessage)(nil),               // 97: iims.PriceTierMessage
+	(*PriceListItemMessage)(nil),           // 98: iims.PriceListItemMessage
+	(*InsertPriceListRequest)(nil),         // 99: iims.InsertPriceListRequest
+	(*InsertPriceListResponse)(nil),        // 100: iims.InsertPriceListResponse
+	(*GetPriceListsRequest)(nil),           // 101: iims.GetPriceListsRequest
+	(*GetByIdPriceListRequest)(nil),        // 102: iims.GetByIdPriceListRequest
+	(*GetPriceListMessage)(nil),            // 103: iims.GetPriceListMessage
+	(*GetPriceListsResponse)(nil),          // 104: iims.GetPriceListsResponse
+	(*UpdatePriceListRequest)(nil),         // 105: iims.UpdatePriceListRequest
+	(*DeletePriceListRequest)(nil),         // 106: iims.DeletePriceListRequest
+	(*ResolvePriceRequest)(nil),            // 107: iims.ResolvePriceRequest
+	(*ResolvePriceResponse)(nil),           // 108: iims.ResolvePriceResponse
+	(*InsertCouponRequest)(nil),            // 109: iims.InsertCouponRequest
+	(*InsertCouponResponse)(nil),           // 110: iims.InsertCouponResponse
+	(*GenerateCouponsRequest)(nil),         // 111: iims.GenerateCouponsRequest
+	(*GenerateCouponsResponse)(nil),        // 112: iims.GenerateCouponsResponse
+	(*CouponCodeRequest)(nil),              // 113: iims.CouponCodeRequest
+	(*CouponMessage)(nil),                  // 114: iims.CouponMessage
+	(*ValidateCouponResponse)(nil),         // 115: iims.ValidateCouponResponse
+	(*RedeemCouponResponse)(nil),           // 116: iims.RedeemCouponResponse
+	nil,                                    // 117: iims.InsertProductRequest.AttributesEntry
+	nil,                                    // 118: iims.GetProductsRequest.AttributesEntry
+	nil,                                    // 119: iims.GetProductMessage.AttributesEntry
+	nil,                                    // 120: iims.VariantMessage.AttributesEntry
+	nil,                                    // 121: iims.InsertVariantRequest.AttributesEntry
+	nil,                                    // 122: iims.UpdateVariantRequest.AttributesEntry
+	nil,                                    // 123: iims.UpdateProductRequest.AttributesEntry
+	(*timestamppb.Timestamp)(nil),          // 124: google.protobuf.Timestamp
+	(*emptypb.Empty)(nil),                  // 125: google.protobuf.Empty
 }
 var file_iims_proto_depIdxs = []int32{
-	5,  // 0: iims.GetProductsResponse.Products:type_name -> iims.GetProductMessage
-	13, // 1: iims.GetSalesResponse.Sales:type_name -> iims.GetSaleMessage
-	0,  // 2: iims.ProductService.InsertOne:input_type -> iims.InsertProductRequest
-	4,  // 3: iims.ProductService.Get:input_type -> iims.GetProductsRequest
-	2,  // 4: iims.ProductService.GetById:input_type -> iims.GetByIdProductRequest
-	1,  // 5: iims.ProductService.GetByProductCode:input_type -> iims.GetByProductCodeRequest
-	7,  // 6: iims.ProductService.Delete:input_type -> iims.DeleteProductRequest
-	8,  // 7: iims.ProductService.Update:input_type -> iims.UpdateProductRequest
-	9,  // 8: iims.ProductService.BlockProduct:input_type -> iims.BlockProductOperationMessage
-	9,  // 9: iims.ProductService.UnblockProduct:input_type -> iims.BlockProductOperationMessage
-	10, // 10: iims.SaleService.InsertOne:input_type -> iims.InsertSaleRequest
-	12, // 11: iims.SaleService.Get:input_type -> iims.GetSalesRequest
-	15, // 12: iims.SaleService.Delete:input_type -> iims.DeleteSaleRequest
-	16, // 13: iims.SaleService.Update:input_type -> iims.UpdateSaleRequest
-	17, // 14: iims.SaleService.BlockSale:input_type -> iims.BlockSaleOperationMessage
-	17, // 15: iims.SaleService.UnblockSale:input_type -> iims.BlockSaleOperationMessage
-	3,  // 16: iims.ProductService.InsertOne:output_type -> iims.InsertProductResponse
-	6,  // 17: iims.ProductService.Get:output_type -> iims.GetProductsResponse
-	5,  // 18: iims.ProductService.GetById:output_type -> iims.GetProductMessage
-	5,  // 19: iims.ProductService.GetByProductCode:output_type -> iims.GetProductMessage
-	18, // 20: iims.ProductService.Delete:output_type -> google.protobuf.Empty
-	18, // 21: iims.ProductService.Update:output_type -> google.protobuf.Empty
-	18, // 22: iims.ProductService.BlockProduct:output_type -> google.protobuf.Empty
-	18, // 23: iims.ProductService.UnblockProduct:output_type -> google.protobuf.Empty
-	11, // 24: iims.SaleService.InsertOne:output_type -> iims.InsertSaleResponse
-	14, // 25: iims.SaleService.Get:output_type -> iims.GetSalesResponse
-	18, // 26: iims.SaleService.Delete:output_type -> google.protobuf.Empty
-	18, // 27: iims.SaleService.Update:output_type -> google.protobuf.Empty
-	18, // 28: iims.SaleService.BlockSale:output_type -> google.protobuf.Empty
-	18, // 29: iims.SaleService.UnblockSale:output_type -> google.protobuf.Empty
-	16, // [16:30] is the sub-list for method output_type
-	2,  // [2:16] is the sub-list for method input_type
-	2,  // [2:2] is the sub-list for extension type_name
-	2,  // [2:2] is the sub-list for extension extendee
-	0,  // [0:2] is the sub-list for field type_name
+	117, // 0: iims.InsertProductRequest.Attributes:type_name -> iims.InsertProductRequest.AttributesEntry
+	12,  // 1: iims.InsertProductRequest.Price:type_name -> iims.Money
+	22,  // 2: iims.ScoredProductMessage.Product:type_name -> iims.GetProductMessage
+	17,  // 3: iims.SearchProductsResponse.Results:type_name -> iims.ScoredProductMessage
+	124, // 4: iims.GetProductsRequest.CreatedFrom:type_name -> google.protobuf.Timestamp
+	124, // 5: iims.GetProductsRequest.CreatedTo:type_name -> google.protobuf.Timestamp
+	0,   // 6: iims.GetProductsRequest.SortField:type_name -> iims.ProductSortField
+	1,   // 7: iims.GetProductsRequest.SortDirection:type_name -> iims.SortDirection
+	118, // 8: iims.GetProductsRequest.Attributes:type_name -> iims.GetProductsRequest.AttributesEntry
+	12,  // 9: iims.GetProductsRequest.MinPrice:type_name -> iims.Money
+	12,  // 10: iims.GetProductsRequest.MaxPrice:type_name -> iims.Money
+	23,  // 11: iims.GetProductMessage.Variants:type_name -> iims.VariantMessage
+	119, // 12: iims.GetProductMessage.Attributes:type_name -> iims.GetProductMessage.AttributesEntry
+	12,  // 13: iims.GetProductMessage.Price:type_name -> iims.Money
+	124, // 14: iims.GetProductMessage.DeletedAt:type_name -> google.protobuf.Timestamp
+	124, // 15: iims.GetProductMessage.BlockedAt:type_name -> google.protobuf.Timestamp
+	120, // 16: iims.VariantMessage.Attributes:type_name -> iims.VariantMessage.AttributesEntry
+	12,  // 17: iims.VariantMessage.Price:type_name -> iims.Money
+	12,  // 18: iims.VariantMessage.EffectivePrice:type_name -> iims.Money
+	121, // 19: iims.InsertVariantRequest.Attributes:type_name -> iims.InsertVariantRequest.AttributesEntry
+	12,  // 20: iims.InsertVariantRequest.Price:type_name -> iims.Money
+	122, // 21: iims.UpdateVariantRequest.Attributes:type_name -> iims.UpdateVariantRequest.AttributesEntry
+	12,  // 22: iims.UpdateVariantRequest.Price:type_name -> iims.Money
+	12,  // 23: iims.SchedulePriceChangeRequest.Price:type_name -> iims.Money
+	124, // 24: iims.SchedulePriceChangeRequest.EffectiveFrom:type_name -> google.protobuf.Timestamp
+	124, // 25: iims.GetPriceAtRequest.At:type_name -> google.protobuf.Timestamp
+	12,  // 26: iims.PriceChangeMessage.Price:type_name -> iims.Money
+	2,   // 27: iims.PriceChangeMessage.Status:type_name -> iims.PriceChangeStatus
+	124, // 28: iims.PriceChangeMessage.EffectiveFrom:type_name -> google.protobuf.Timestamp
+	124, // 29: iims.PriceChangeMessage.EffectiveTo:type_name -> google.protobuf.Timestamp
+	22,  // 30: iims.GetProductsResponse.Products:type_name -> iims.GetProductMessage
+	123, // 31: iims.UpdateProductRequest.Attributes:type_name -> iims.UpdateProductRequest.AttributesEntry
+	12,  // 32: iims.UpdateProductRequest.Price:type_name -> iims.Money
+	38,  // 33: iims.ProductAvailabilityMessage.Warehouses:type_name -> iims.WarehouseAvailabilityMessage
+	124, // 34: iims.InsertSaleRequest.StartsAt:type_name -> google.protobuf.Timestamp
+	124, // 35: iims.InsertSaleRequest.EndsAt:type_name -> google.protobuf.Timestamp
+	4,   // 36: iims.InsertSaleRequest.DiscountType:type_name -> iims.DiscountType
+	12,  // 37: iims.InsertSaleRequest.Amount:type_name -> iims.Money
+	5,   // 38: iims.InsertSaleRequest.Target:type_name -> iims.SaleTarget
+	124, // 39: iims.GetSalesRequest.ActiveAt:type_name -> google.protobuf.Timestamp
+	124, // 40: iims.GetSaleMessage.StartsAt:type_name -> google.protobuf.Timestamp
+	124, // 41: iims.GetSaleMessage.EndsAt:type_name -> google.protobuf.Timestamp
+	3,   // 42: iims.GetSaleMessage.Status:type_name -> iims.SaleStatus
+	4,   // 43: iims.GetSaleMessage.DiscountType:type_name -> iims.DiscountType
+	12,  // 44: iims.GetSaleMessage.Amount:type_name -> iims.Money
+	5,   // 45: iims.GetSaleMessage.Target:type_name -> iims.SaleTarget
+	124, // 46: iims.GetSaleMessage.DeletedAt:type_name -> google.protobuf.Timestamp
+	124, // 47: iims.GetSaleMessage.BlockedAt:type_name -> google.protobuf.Timestamp
+	43,  // 48: iims.GetSalesResponse.Sales:type_name -> iims.GetSaleMessage
+	124, // 49: iims.UpdateSaleRequest.StartsAt:type_name -> google.protobuf.Timestamp
+	124, // 50: iims.UpdateSaleRequest.EndsAt:type_name -> google.protobuf.Timestamp
+	4,   // 51: iims.UpdateSaleRequest.DiscountType:type_name -> iims.DiscountType
+	12,  // 52: iims.UpdateSaleRequest.Amount:type_name -> iims.Money
+	51,  // 53: iims.CalculatePriceRequest.Lines:type_name -> iims.PriceLineRequest
+	6,   // 54: iims.CalculatePriceRequest.Policy:type_name -> iims.SaleCombinePolicy
+	12,  // 55: iims.AppliedSaleMessage.Discount:type_name -> iims.Money
+	4,   // 56: iims.AppliedSaleMessage.DiscountType:type_name -> iims.DiscountType
+	12,  // 57: iims.PricedLineMessage.BasePrice:type_name -> iims.Money
+	53,  // 58: iims.PricedLineMessage.Sales:type_name -> iims.AppliedSaleMessage
+	12,  // 59: iims.PricedLineMessage.Discount:type_name -> iims.Money
+	12,  // 60: iims.PricedLineMessage.LineTotal:type_name -> iims.Money
+	54,  // 61: iims.CalculatePriceResponse.Lines:type_name -> iims.PricedLineMessage
+	12,  // 62: iims.CalculatePriceResponse.Total:type_name -> iims.Money
+	6,   // 63: iims.CalculatePriceResponse.Policy:type_name -> iims.SaleCombinePolicy
+	7,   // 64: iims.AdjustStockRequest.Reason:type_name -> iims.MovementReason
+	124, // 65: iims.StockMessage.UpdatedAt:type_name -> google.protobuf.Timestamp
+	124, // 66: iims.ListMovementsRequest.From:type_name -> google.protobuf.Timestamp
+	124, // 67: iims.ListMovementsRequest.To:type_name -> google.protobuf.Timestamp
+	7,   // 68: iims.StockMovementMessage.Reason:type_name -> iims.MovementReason
+	124, // 69: iims.StockMovementMessage.CreatedAt:type_name -> google.protobuf.Timestamp
+	61,  // 70: iims.ListMovementsResponse.Movements:type_name -> iims.StockMovementMessage
+	64,  // 71: iims.RebuildBalancesResponse.Drifts:type_name -> iims.BalanceDriftMessage
+	70,  // 72: iims.GetWarehousesResponse.Warehouses:type_name -> iims.GetWarehouseMessage
+	8,   // 73: iims.TransferMessage.Status:type_name -> iims.TransferStatus
+	124, // 74: iims.TransferMessage.CreatedAt:type_name -> google.protobuf.Timestamp
+	124, // 75: iims.TransferMessage.ShippedAt:type_name -> google.protobuf.Timestamp
+	124, // 76: iims.TransferMessage.ReceivedAt:type_name -> google.protobuf.Timestamp
+	82,  // 77: iims.GetCategoriesResponse.Categories:type_name -> iims.GetCategoryMessage
+	82,  // 78: iims.CategoryTreeNode.Category:type_name -> iims.GetCategoryMessage
+	87,  // 79: iims.CategoryTreeNode.Children:type_name -> iims.CategoryTreeNode
+	87,  // 80: iims.GetCategoryTreeResponse.Roots:type_name -> iims.CategoryTreeNode
+	9,   // 81: iims.InsertAttributeRequest.Type:type_name -> iims.AttributeType
+	9,   // 82: iims.GetAttributeMessage.Type:type_name -> iims.AttributeType
+	93,  // 83: iims.GetAttributesResponse.Attributes:type_name -> iims.GetAttributeMessage
+	12,  // 84: iims.PriceTierMessage.Price:type_name -> iims.Money
+	97,  // 85: iims.PriceListItemMessage.Tiers:type_name -> iims.PriceTierMessage
+	10,  // 86: iims.InsertPriceListRequest.Channel:type_name -> iims.SalesChannel
+	124, // 87: iims.InsertPriceListRequest.ValidFrom:type_name -> google.protobuf.Timestamp
+	124, // 88: iims.InsertPriceListRequest.ValidTo:type_name -> google.protobuf.Timestamp
+	98,  // 89: iims.InsertPriceListRequest.Items:type_name -> iims.PriceListItemMessage
+	10,  // 90: iims.GetPriceListsRequest.Channel:type_name -> iims.SalesChannel
+	10,  // 91: iims.GetPriceListMessage.Channel:type_name -> iims.SalesChannel
+	124, // 92: iims.GetPriceListMessage.ValidFrom:type_name -> google.protobuf.Timestamp
+	124, // 93: iims.GetPriceListMessage.ValidTo:type_name -> google.protobuf.Timestamp
+	98,  // 94: iims.GetPriceListMessage.Items:type_name -> iims.PriceListItemMessage
+	103, // 95: iims.GetPriceListsResponse.PriceLists:type_name -> iims.GetPriceListMessage
+	10,  // 96: iims.UpdatePriceListRequest.Channel:type_name -> iims.SalesChannel
+	124, // 97: iims.UpdatePriceListRequest.ValidFrom:type_name -> google.protobuf.Timestamp
+	124, // 98: iims.UpdatePriceListRequest.ValidTo:type_name -> google.protobuf.Timestamp
+	98,  // 99: iims.UpdatePriceListRequest.Items:type_name -> iims.PriceListItemMessage
+	10,  // 100: iims.ResolvePriceRequest.Channel:type_name -> iims.SalesChannel
+	12,  // 101: iims.ResolvePriceResponse.UnitPrice:type_name -> iims.Money
+	12,  // 102: iims.ResolvePriceResponse.Total:type_name -> iims.Money
+	124, // 103: iims.InsertCouponRequest.ExpiresAt:type_name -> google.protobuf.Timestamp
+	124, // 104: iims.GenerateCouponsRequest.ExpiresAt:type_name -> google.protobuf.Timestamp
+	124, // 105: iims.CouponMessage.ExpiresAt:type_name -> google.protobuf.Timestamp
+	11,  // 106: iims.ValidateCouponResponse.Rejection:type_name -> iims.CouponRejection
+	114, // 107: iims.ValidateCouponResponse.Coupon:type_name -> iims.CouponMessage
+	114, // 108: iims.RedeemCouponResponse.Coupon:type_name -> iims.CouponMessage
+	13,  // 109: iims.ProductService.InsertOne:input_type -> iims.InsertProductRequest
+	21,  // 110: iims.ProductService.Get:input_type -> iims.GetProductsRequest
+	19,  // 111: iims.ProductService.GetById:input_type -> iims.GetByIdProductRequest
+	14,  // 112: iims.ProductService.GetByProductCode:input_type -> iims.GetByProductCodeRequest
+	15,  // 113: iims.ProductService.GetByBarcode:input_type -> iims.GetByBarcodeRequest
+	16,  // 114: iims.ProductService.SearchProducts:input_type -> iims.SearchProductsRequest
+	32,  // 115: iims.ProductService.Delete:input_type -> iims.DeleteProductRequest
+	33,  // 116: iims.ProductService.Restore:input_type -> iims.RestoreProductRequest
+	34,  // 117: iims.ProductService.Purge:input_type -> iims.PurgeProductRequest
+	35,  // 118: iims.ProductService.Update:input_type -> iims.UpdateProductRequest
+	36,  // 119: iims.ProductService.BlockProduct:input_type -> iims.BlockProductOperationMessage
+	36,  // 120: iims.ProductService.UnblockProduct:input_type -> iims.BlockProductOperationMessage
+	37,  // 121: iims.ProductService.GetAvailability:input_type -> iims.GetAvailabilityRequest
+	24,  // 122: iims.ProductService.InsertVariant:input_type -> iims.InsertVariantRequest
+	26,  // 123: iims.ProductService.UpdateVariant:input_type -> iims.UpdateVariantRequest
+	27,  // 124: iims.ProductService.DeleteVariant:input_type -> iims.DeleteVariantRequest
+	28,  // 125: iims.ProductService.SchedulePriceChange:input_type -> iims.SchedulePriceChangeRequest
+	29,  // 126: iims.ProductService.GetPriceAt:input_type -> iims.GetPriceAtRequest
+	40,  // 127: iims.SaleService.InsertOne:input_type -> iims.InsertSaleRequest
+	42,  // 128: iims.SaleService.Get:input_type -> iims.GetSalesRequest
+	46,  // 129: iims.SaleService.Delete:input_type -> iims.DeleteSaleRequest
+	47,  // 130: iims.SaleService.Restore:input_type -> iims.RestoreSaleRequest
+	48,  // 131: iims.SaleService.Purge:input_type -> iims.PurgeSaleRequest
+	49,  // 132: iims.SaleService.Update:input_type -> iims.UpdateSaleRequest
+	50,  // 133: iims.SaleService.BlockSale:input_type -> iims.BlockSaleOperationMessage
+	50,  // 134: iims.SaleService.UnblockSale:input_type -> iims.BlockSaleOperationMessage
+	52,  // 135: iims.SaleService.CalculatePrice:input_type -> iims.CalculatePriceRequest
+	45,  // 136: iims.SaleService.ListSalesForProduct:input_type -> iims.ListSalesForProductRequest
+	56,  // 137: iims.StockService.GetStock:input_type -> iims.GetStockRequest
+	57,  // 138: iims.StockService.AdjustStock:input_type -> iims.AdjustStockRequest
+	58,  // 139: iims.StockService.Reserve:input_type -> iims.StockReservationRequest
+	58,  // 140: iims.StockService.Release:input_type -> iims.StockReservationRequest
+	60,  // 141: iims.StockService.ListMovements:input_type -> iims.ListMovementsRequest
+	63,  // 142: iims.StockService.RebuildBalances:input_type -> iims.RebuildBalancesRequest
+	66,  // 143: iims.WarehouseService.InsertOne:input_type -> iims.InsertWarehouseRequest
+	68,  // 144: iims.WarehouseService.Get:input_type -> iims.GetWarehousesRequest
+	69,  // 145: iims.WarehouseService.GetById:input_type -> iims.GetByIdWarehouseRequest
+	72,  // 146: iims.WarehouseService.Delete:input_type -> iims.DeleteWarehouseRequest
+	73,  // 147: iims.WarehouseService.Update:input_type -> iims.UpdateWarehouseRequest
+	74,  // 148: iims.WarehouseService.BlockWarehouse:input_type -> iims.BlockWarehouseOperationMessage
+	74,  // 149: iims.WarehouseService.UnblockWarehouse:input_type -> iims.BlockWarehouseOperationMessage
+	75,  // 150: iims.TransferService.CreateTransfer:input_type -> iims.CreateTransferRequest
+	76,  // 151: iims.TransferService.ShipTransfer:input_type -> iims.TransferOperationMessage
+	76,  // 152: iims.TransferService.ReceiveTransfer:input_type -> iims.TransferOperationMessage
+	78,  // 153: iims.CategoryService.InsertOne:input_type -> iims.InsertCategoryRequest
+	80,  // 154: iims.CategoryService.Get:input_type -> iims.GetCategoriesRequest
+	81,  // 155: iims.CategoryService.GetById:input_type -> iims.GetByIdCategoryRequest
+	84,  // 156: iims.CategoryService.Delete:input_type -> iims.DeleteCategoryRequest
+	85,  // 157: iims.CategoryService.Update:input_type -> iims.UpdateCategoryRequest
+	86,  // 158: iims.CategoryService.GetTree:input_type -> iims.GetCategoryTreeRequest
+	89,  // 159: iims.CategoryService.MoveCategory:input_type -> iims.MoveCategoryRequest
+	90,  // 160: iims.AttributeService.InsertOne:input_type -> iims.InsertAttributeRequest
+	92,  // 161: iims.AttributeService.Get:input_type -> iims.GetAttributesRequest
+	95,  // 162: iims.AttributeService.Update:input_type -> iims.UpdateAttributeRequest
+	96,  // 163: iims.AttributeService.Delete:input_type -> iims.DeleteAttributeRequest
+	99,  // 164: iims.PriceListService.InsertOne:input_type -> iims.InsertPriceListRequest
+	101, // 165: iims.PriceListService.Get:input_type -> iims.GetPriceListsRequest
+	102, // 166: iims.PriceListService.GetById:input_type -> iims.GetByIdPriceListRequest
+	105, // 167: iims.PriceListService.Update:input_type -> iims.UpdatePriceListRequest
+	106, // 168: iims.PriceListService.Delete:input_type -> iims.DeletePriceListRequest
+	107, // 169: iims.PriceListService.ResolvePrice:input_type -> iims.ResolvePriceRequest
+	109, // 170: iims.CouponService.InsertOne:input_type -> iims.InsertCouponRequest
+	111, // 171: iims.CouponService.Generate:input_type -> iims.GenerateCouponsRequest
+	113, // 172: iims.CouponService.ValidateCoupon:input_type -> iims.CouponCodeRequest
+	113, // 173: iims.CouponService.RedeemCoupon:input_type -> iims.CouponCodeRequest
+	20,  // 174: iims.ProductService.InsertOne:output_type -> iims.InsertProductResponse
+	31,  // 175: iims.ProductService.Get:output_type -> iims.GetProductsResponse
+	22,  // 176: iims.ProductService.GetById:output_type -> iims.GetProductMessage
+	22,  // 177: iims.ProductService.GetByProductCode:output_type -> iims.GetProductMessage
+	22,  // 178: iims.ProductService.GetByBarcode:output_type -> iims.GetProductMessage
+	18,  // 179: iims.ProductService.SearchProducts:output_type -> iims.SearchProductsResponse
+	125, // 180: iims.ProductService.Delete:output_type -> google.protobuf.Empty
+	125, // 181: iims.ProductService.Restore:output_type -> google.protobuf.Empty
+	125, // 182: iims.ProductService.Purge:output_type -> google.protobuf.Empty
+	125, // 183: iims.ProductService.Update:output_type -> google.protobuf.Empty
+	125, // 184: iims.ProductService.BlockProduct:output_type -> google.protobuf.Empty
+	125, // 185: iims.ProductService.UnblockProduct:output_type -> google.protobuf.Empty
+	39,  // 186: iims.ProductService.GetAvailability:output_type -> iims.ProductAvailabilityMessage
+	25,  // 187: iims.ProductService.InsertVariant:output_type -> iims.InsertVariantResponse
+	125, // 188: iims.ProductService.UpdateVariant:output_type -> google.protobuf.Empty
+	125, // 189: iims.ProductService.DeleteVariant:output_type -> google.protobuf.Empty
+	30,  // 190: iims.ProductService.SchedulePriceChange:output_type -> iims.PriceChangeMessage
+	30,  // 191: iims.ProductService.GetPriceAt:output_type -> iims.PriceChangeMessage
+	41,  // 192: iims.SaleService.InsertOne:output_type -> iims.InsertSaleResponse
+	44,  // 193: iims.SaleService.Get:output_type -> iims.GetSalesResponse
+	125, // 194: iims.SaleService.Delete:output_type -> google.protobuf.Empty
+	125, // 195: iims.SaleService.Restore:output_type -> google.protobuf.Empty
+	125, // 196: iims.SaleService.Purge:output_type -> google.protobuf.Empty
+	125, // 197: iims.SaleService.Update:output_type -> google.protobuf.Empty
+	125, // 198: iims.SaleService.BlockSale:output_type -> google.protobuf.Empty
+	125, // 199: iims.SaleService.UnblockSale:output_type -> google.protobuf.Empty
+	55,  // 200: iims.SaleService.CalculatePrice:output_type -> iims.CalculatePriceResponse
+	44,  // 201: iims.SaleService.ListSalesForProduct:output_type -> iims.GetSalesResponse
+	59,  // 202: iims.StockService.GetStock:output_type -> iims.StockMessage
+	59,  // 203: iims.StockService.AdjustStock:output_type -> iims.StockMessage
+	59,  // 204: iims.StockService.Reserve:output_type -> iims.StockMessage
+	59,  // 205: iims.StockService.Release:output_type -> iims.StockMessage
+	62,  // 206: iims.StockService.ListMovements:output_type -> iims.ListMovementsResponse
+	65,  // 207: iims.StockService.RebuildBalances:output_type -> iims.RebuildBalancesResponse
+	67,  // 208: iims.WarehouseService.InsertOne:output_type -> iims.InsertWarehouseResponse
+	71,  // 209: iims.WarehouseService.Get:output_type -> iims.GetWarehousesResponse
+	70,  // 210: iims.WarehouseService.GetById:output_type -> iims.GetWarehouseMessage
+	125, // 211: iims.WarehouseService.Delete:output_type -> google.protobuf.Empty
+	125, // 212: iims.WarehouseService.Update:output_type -> google.protobuf.Empty
+	125, // 213: iims.WarehouseService.BlockWarehouse:output_type -> google.protobuf.Empty
+	125, // 214: iims.WarehouseService.UnblockWarehouse:output_type -> google.protobuf.Empty
+	77,  // 215: iims.TransferService.CreateTransfer:output_type -> iims.TransferMessage
+	77,  // 216: iims.TransferService.ShipTransfer:output_type -> iims.TransferMessage
+	77,  // 217: iims.TransferService.ReceiveTransfer:output_type -> iims.TransferMessage
+	79,  // 218: iims.CategoryService.InsertOne:output_type -> iims.InsertCategoryResponse
+	83,  // 219: iims.CategoryService.Get:output_type -> iims.GetCategoriesResponse
+	82,  // 220: iims.CategoryService.GetById:output_type -> iims.GetCategoryMessage
+	125, // 221: iims.CategoryService.Delete:output_type -> google.protobuf.Empty
+	125, // 222: iims.CategoryService.Update:output_type -> google.protobuf.Empty
+	88,  // 223: iims.CategoryService.GetTree:output_type -> iims.GetCategoryTreeResponse
+	125, // 224: iims.CategoryService.MoveCategory:output_type -> google.protobuf.Empty
+	91,  // 225: iims.AttributeService.InsertOne:output_type -> iims.InsertAttributeResponse
+	94,  // 226: iims.AttributeService.Get:output_type -> iims.GetAttributesResponse
+	125, // 227: iims.AttributeService.Update:output_type -> google.protobuf.Empty
+	125, // 228: iims.AttributeService.Delete:output_type -> google.protobuf.Empty
+	100, // 229: iims.PriceListService.InsertOne:output_type -> iims.InsertPriceListResponse
+	104, // 230: iims.PriceListService.Get:output_type -> iims.GetPriceListsResponse
+	103, // 231: iims.PriceListService.GetById:output_type -> iims.GetPriceListMessage
+	125, // 232: iims.PriceListService.Update:output_type -> google.protobuf.Empty
+	125, // 233: iims.PriceListService.Delete:output_type -> google.protobuf.Empty
+	108, // 234: iims.PriceListService.ResolvePrice:output_type -> iims.ResolvePriceResponse
+	110, // 235: iims.CouponService.InsertOne:output_type -> iims.InsertCouponResponse
+	112, // 236: iims.CouponService.Generate:output_type -> iims.GenerateCouponsResponse
+	115, // 237: iims.CouponService.ValidateCoupon:output_type -> iims.ValidateCouponResponse
+	116, // 238: iims.CouponService.RedeemCoupon:output_type -> iims.RedeemCouponResponse
+	174, // [174:239] is the sub-list for method output_type
+	109, // [109:174] is the sub-list for method input_type
+	109, // [109:109] is the sub-list for extension type_name
+	109, // [109:109] is the sub-list for extension extendee
+	0,   // [0:109] is the sub-list for field type_name
 }
 
 func init() { file_iims_proto_init() }
@@ -1157,18 +8839,20 @@ func file_iims_proto_init() {
 	if File_iims_proto != nil {
 		return
 	}
+	file_iims_proto_msgTypes[9].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_iims_proto_rawDesc), len(file_iims_proto_rawDesc)),
-			NumEnums:      0,
-			NumMessages:   18,
+			NumEnums:      12,
+			NumMessages:   112,
 			NumExtensions: 0,
-			NumServices:   2,
+			NumServices:   9,
 		},
 		GoTypes:           file_iims_proto_goTypes,
 		DependencyIndexes: file_iims_proto_depIdxs,
+		EnumInfos:         file_iims_proto_enumTypes,
 		MessageInfos:      file_iims_proto_msgTypes,
 	}.Build()
 	File_iims_proto = out.File
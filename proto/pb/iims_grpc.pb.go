@@ -20,14 +20,24 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	ProductService_InsertOne_FullMethodName        = "/iims.ProductService/InsertOne"
-	ProductService_Get_FullMethodName              = "/iims.ProductService/Get"
-	ProductService_GetById_FullMethodName          = "/iims.ProductService/GetById"
-	ProductService_GetByProductCode_FullMethodName = "/iims.ProductService/GetByProductCode"
-	ProductService_Delete_FullMethodName           = "/iims.ProductService/Delete"
-	ProductService_Update_FullMethodName           = "/iims.ProductService/Update"
-	ProductService_BlockProduct_FullMethodName     = "/iims.ProductService/BlockProduct"
-	ProductService_UnblockProduct_FullMethodName   = "/iims.ProductService/UnblockProduct"
+	ProductService_InsertOne_FullMethodName           = "/iims.ProductService/InsertOne"
+	ProductService_Get_FullMethodName                 = "/iims.ProductService/Get"
+	ProductService_GetById_FullMethodName             = "/iims.ProductService/GetById"
+	ProductService_GetByProductCode_FullMethodName    = "/iims.ProductService/GetByProductCode"
+	ProductService_GetByBarcode_FullMethodName        = "/iims.ProductService/GetByBarcode"
+	ProductService_SearchProducts_FullMethodName      = "/iims.ProductService/SearchProducts"
+	ProductService_Delete_FullMethodName              = "/iims.ProductService/Delete"
+	ProductService_Restore_FullMethodName             = "/iims.ProductService/Restore"
+	ProductService_Purge_FullMethodName               = "/iims.ProductService/Purge"
+	ProductService_Update_FullMethodName              = "/iims.ProductService/Update"
+	ProductService_BlockProduct_FullMethodName        = "/iims.ProductService/BlockProduct"
+	ProductService_UnblockProduct_FullMethodName      = "/iims.ProductService/UnblockProduct"
+	ProductService_GetAvailability_FullMethodName     = "/iims.ProductService/GetAvailability"
+	ProductService_InsertVariant_FullMethodName       = "/iims.ProductService/InsertVariant"
+	ProductService_UpdateVariant_FullMethodName       = "/iims.ProductService/UpdateVariant"
+	ProductService_DeleteVariant_FullMethodName       = "/iims.ProductService/DeleteVariant"
+	ProductService_SchedulePriceChange_FullMethodName = "/iims.ProductService/SchedulePriceChange"
+	ProductService_GetPriceAt_FullMethodName          = "/iims.ProductService/GetPriceAt"
 )
 
 // ProductServiceClient is the client API for ProductService service.
@@ -38,10 +48,24 @@ type ProductServiceClient interface {
 	Get(ctx context.Context, in *GetProductsRequest, opts ...grpc.CallOption) (*GetProductsResponse, error)
 	GetById(ctx context.Context, in *GetByIdProductRequest, opts ...grpc.CallOption) (*GetProductMessage, error)
 	GetByProductCode(ctx context.Context, in *GetByProductCodeRequest, opts ...grpc.CallOption) (*GetProductMessage, error)
+	GetByBarcode(ctx context.Context, in *GetByBarcodeRequest, opts ...grpc.CallOption) (*GetProductMessage, error)
+	// SearchProducts runs a full-text search over name, description and product code. It matches
+	// whole words after stemming in the configured text_search_language, so a partial word such as
+	// "wid" does not find "widget"; use NameContains of Get for substring matches. A changed
+	// language takes effect on the next start, when the search index is rebuilt.
+	SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error)
 	Delete(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Restore(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Purge(ctx context.Context, in *PurgeProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Update(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	BlockProduct(ctx context.Context, in *BlockProductOperationMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnblockProduct(ctx context.Context, in *BlockProductOperationMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*ProductAvailabilityMessage, error)
+	InsertVariant(ctx context.Context, in *InsertVariantRequest, opts ...grpc.CallOption) (*InsertVariantResponse, error)
+	UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteVariant(ctx context.Context, in *DeleteVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*PriceChangeMessage, error)
+	GetPriceAt(ctx context.Context, in *GetPriceAtRequest, opts ...grpc.CallOption) (*PriceChangeMessage, error)
 }
 
 type productServiceClient struct {
@@ -92,6 +116,26 @@ func (c *productServiceClient) GetByProductCode(ctx context.Context, in *GetByPr
 	return out, nil
 }
 
+func (c *productServiceClient) GetByBarcode(ctx context.Context, in *GetByBarcodeRequest, opts ...grpc.CallOption) (*GetProductMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetProductMessage)
+	err := c.cc.Invoke(ctx, ProductService_GetByBarcode_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SearchProducts(ctx context.Context, in *SearchProductsRequest, opts ...grpc.CallOption) (*SearchProductsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchProductsResponse)
+	err := c.cc.Invoke(ctx, ProductService_SearchProducts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) Delete(ctx context.Context, in *DeleteProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -102,6 +146,26 @@ func (c *productServiceClient) Delete(ctx context.Context, in *DeleteProductRequ
 	return out, nil
 }
 
+func (c *productServiceClient) Restore(ctx context.Context, in *RestoreProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProductService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) Purge(ctx context.Context, in *PurgeProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProductService_Purge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *productServiceClient) Update(ctx context.Context, in *UpdateProductRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -132,6 +196,66 @@ func (c *productServiceClient) UnblockProduct(ctx context.Context, in *BlockProd
 	return out, nil
 }
 
+func (c *productServiceClient) GetAvailability(ctx context.Context, in *GetAvailabilityRequest, opts ...grpc.CallOption) (*ProductAvailabilityMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProductAvailabilityMessage)
+	err := c.cc.Invoke(ctx, ProductService_GetAvailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) InsertVariant(ctx context.Context, in *InsertVariantRequest, opts ...grpc.CallOption) (*InsertVariantResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertVariantResponse)
+	err := c.cc.Invoke(ctx, ProductService_InsertVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) UpdateVariant(ctx context.Context, in *UpdateVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProductService_UpdateVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) DeleteVariant(ctx context.Context, in *DeleteVariantRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ProductService_DeleteVariant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*PriceChangeMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceChangeMessage)
+	err := c.cc.Invoke(ctx, ProductService_SchedulePriceChange_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *productServiceClient) GetPriceAt(ctx context.Context, in *GetPriceAtRequest, opts ...grpc.CallOption) (*PriceChangeMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PriceChangeMessage)
+	err := c.cc.Invoke(ctx, ProductService_GetPriceAt_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProductServiceServer is the server API for ProductService service.
 // All implementations must embed UnimplementedProductServiceServer
 // for forward compatibility.
@@ -140,10 +264,24 @@ type ProductServiceServer interface {
 	Get(context.Context, *GetProductsRequest) (*GetProductsResponse, error)
 	GetById(context.Context, *GetByIdProductRequest) (*GetProductMessage, error)
 	GetByProductCode(context.Context, *GetByProductCodeRequest) (*GetProductMessage, error)
+	GetByBarcode(context.Context, *GetByBarcodeRequest) (*GetProductMessage, error)
+	// SearchProducts runs a full-text search over name, description and product code. It matches
+	// whole words after stemming in the configured text_search_language, so a partial word such as
+	// "wid" does not find "widget"; use NameContains of Get for substring matches. A changed
+	// language takes effect on the next start, when the search index is rebuilt.
+	SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error)
 	Delete(context.Context, *DeleteProductRequest) (*emptypb.Empty, error)
+	Restore(context.Context, *RestoreProductRequest) (*emptypb.Empty, error)
+	Purge(context.Context, *PurgeProductRequest) (*emptypb.Empty, error)
 	Update(context.Context, *UpdateProductRequest) (*emptypb.Empty, error)
 	BlockProduct(context.Context, *BlockProductOperationMessage) (*emptypb.Empty, error)
 	UnblockProduct(context.Context, *BlockProductOperationMessage) (*emptypb.Empty, error)
+	GetAvailability(context.Context, *GetAvailabilityRequest) (*ProductAvailabilityMessage, error)
+	InsertVariant(context.Context, *InsertVariantRequest) (*InsertVariantResponse, error)
+	UpdateVariant(context.Context, *UpdateVariantRequest) (*emptypb.Empty, error)
+	DeleteVariant(context.Context, *DeleteVariantRequest) (*emptypb.Empty, error)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*PriceChangeMessage, error)
+	GetPriceAt(context.Context, *GetPriceAtRequest) (*PriceChangeMessage, error)
 	mustEmbedUnimplementedProductServiceServer()
 }
 
@@ -166,9 +304,21 @@ func (UnimplementedProductServiceServer) GetById(context.Context, *GetByIdProduc
 func (UnimplementedProductServiceServer) GetByProductCode(context.Context, *GetByProductCodeRequest) (*GetProductMessage, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetByProductCode not implemented")
 }
+func (UnimplementedProductServiceServer) GetByBarcode(context.Context, *GetByBarcodeRequest) (*GetProductMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetByBarcode not implemented")
+}
+func (UnimplementedProductServiceServer) SearchProducts(context.Context, *SearchProductsRequest) (*SearchProductsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchProducts not implemented")
+}
 func (UnimplementedProductServiceServer) Delete(context.Context, *DeleteProductRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedProductServiceServer) Restore(context.Context, *RestoreProductRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedProductServiceServer) Purge(context.Context, *PurgeProductRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 func (UnimplementedProductServiceServer) Update(context.Context, *UpdateProductRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -178,6 +328,24 @@ func (UnimplementedProductServiceServer) BlockProduct(context.Context, *BlockPro
 func (UnimplementedProductServiceServer) UnblockProduct(context.Context, *BlockProductOperationMessage) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnblockProduct not implemented")
 }
+func (UnimplementedProductServiceServer) GetAvailability(context.Context, *GetAvailabilityRequest) (*ProductAvailabilityMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailability not implemented")
+}
+func (UnimplementedProductServiceServer) InsertVariant(context.Context, *InsertVariantRequest) (*InsertVariantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertVariant not implemented")
+}
+func (UnimplementedProductServiceServer) UpdateVariant(context.Context, *UpdateVariantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVariant not implemented")
+}
+func (UnimplementedProductServiceServer) DeleteVariant(context.Context, *DeleteVariantRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVariant not implemented")
+}
+func (UnimplementedProductServiceServer) SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*PriceChangeMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SchedulePriceChange not implemented")
+}
+func (UnimplementedProductServiceServer) GetPriceAt(context.Context, *GetPriceAtRequest) (*PriceChangeMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceAt not implemented")
+}
 func (UnimplementedProductServiceServer) mustEmbedUnimplementedProductServiceServer() {}
 func (UnimplementedProductServiceServer) testEmbeddedByValue()                        {}
 
@@ -271,6 +439,42 @@ func _ProductService_GetByProductCode_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetByBarcode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByBarcodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetByBarcode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetByBarcode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetByBarcode(ctx, req.(*GetByBarcodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SearchProducts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchProductsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SearchProducts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SearchProducts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SearchProducts(ctx, req.(*SearchProductsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteProductRequest)
 	if err := dec(in); err != nil {
@@ -289,6 +493,42 @@ func _ProductService_Delete_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Restore(ctx, req.(*RestoreProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_Purge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).Purge(ctx, req.(*PurgeProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ProductService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateProductRequest)
 	if err := dec(in); err != nil {
@@ -343,6 +583,114 @@ func _ProductService_UnblockProduct_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ProductService_GetAvailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetAvailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetAvailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetAvailability(ctx, req.(*GetAvailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_InsertVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).InsertVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_InsertVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).InsertVariant(ctx, req.(*InsertVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_UpdateVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_UpdateVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).UpdateVariant(ctx, req.(*UpdateVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_DeleteVariant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVariantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).DeleteVariant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_DeleteVariant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).DeleteVariant(ctx, req.(*DeleteVariantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_SchedulePriceChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ProductService_GetPriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProductServiceServer).GetPriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ProductService_GetPriceAt_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProductServiceServer).GetPriceAt(ctx, req.(*GetPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ProductService_ServiceDesc is the grpc.ServiceDesc for ProductService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -366,10 +714,26 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetByProductCode",
 			Handler:    _ProductService_GetByProductCode_Handler,
 		},
+		{
+			MethodName: "GetByBarcode",
+			Handler:    _ProductService_GetByBarcode_Handler,
+		},
+		{
+			MethodName: "SearchProducts",
+			Handler:    _ProductService_SearchProducts_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _ProductService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _ProductService_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _ProductService_Purge_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _ProductService_Update_Handler,
@@ -382,18 +746,46 @@ var ProductService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnblockProduct",
 			Handler:    _ProductService_UnblockProduct_Handler,
 		},
+		{
+			MethodName: "GetAvailability",
+			Handler:    _ProductService_GetAvailability_Handler,
+		},
+		{
+			MethodName: "InsertVariant",
+			Handler:    _ProductService_InsertVariant_Handler,
+		},
+		{
+			MethodName: "UpdateVariant",
+			Handler:    _ProductService_UpdateVariant_Handler,
+		},
+		{
+			MethodName: "DeleteVariant",
+			Handler:    _ProductService_DeleteVariant_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _ProductService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "GetPriceAt",
+			Handler:    _ProductService_GetPriceAt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "iims.proto",
 }
 
 const (
-	SaleService_InsertOne_FullMethodName   = "/iims.SaleService/InsertOne"
-	SaleService_Get_FullMethodName         = "/iims.SaleService/Get"
-	SaleService_Delete_FullMethodName      = "/iims.SaleService/Delete"
-	SaleService_Update_FullMethodName      = "/iims.SaleService/Update"
-	SaleService_BlockSale_FullMethodName   = "/iims.SaleService/BlockSale"
-	SaleService_UnblockSale_FullMethodName = "/iims.SaleService/UnblockSale"
+	SaleService_InsertOne_FullMethodName           = "/iims.SaleService/InsertOne"
+	SaleService_Get_FullMethodName                 = "/iims.SaleService/Get"
+	SaleService_Delete_FullMethodName              = "/iims.SaleService/Delete"
+	SaleService_Restore_FullMethodName             = "/iims.SaleService/Restore"
+	SaleService_Purge_FullMethodName               = "/iims.SaleService/Purge"
+	SaleService_Update_FullMethodName              = "/iims.SaleService/Update"
+	SaleService_BlockSale_FullMethodName           = "/iims.SaleService/BlockSale"
+	SaleService_UnblockSale_FullMethodName         = "/iims.SaleService/UnblockSale"
+	SaleService_CalculatePrice_FullMethodName      = "/iims.SaleService/CalculatePrice"
+	SaleService_ListSalesForProduct_FullMethodName = "/iims.SaleService/ListSalesForProduct"
 )
 
 // SaleServiceClient is the client API for SaleService service.
@@ -403,9 +795,13 @@ type SaleServiceClient interface {
 	InsertOne(ctx context.Context, in *InsertSaleRequest, opts ...grpc.CallOption) (*InsertSaleResponse, error)
 	Get(ctx context.Context, in *GetSalesRequest, opts ...grpc.CallOption) (*GetSalesResponse, error)
 	Delete(ctx context.Context, in *DeleteSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Restore(ctx context.Context, in *RestoreSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Purge(ctx context.Context, in *PurgeSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Update(ctx context.Context, in *UpdateSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	BlockSale(ctx context.Context, in *BlockSaleOperationMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	UnblockSale(ctx context.Context, in *BlockSaleOperationMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CalculatePrice(ctx context.Context, in *CalculatePriceRequest, opts ...grpc.CallOption) (*CalculatePriceResponse, error)
+	ListSalesForProduct(ctx context.Context, in *ListSalesForProductRequest, opts ...grpc.CallOption) (*GetSalesResponse, error)
 }
 
 type saleServiceClient struct {
@@ -446,6 +842,26 @@ func (c *saleServiceClient) Delete(ctx context.Context, in *DeleteSaleRequest, o
 	return out, nil
 }
 
+func (c *saleServiceClient) Restore(ctx context.Context, in *RestoreSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SaleService_Restore_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saleServiceClient) Purge(ctx context.Context, in *PurgeSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, SaleService_Purge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *saleServiceClient) Update(ctx context.Context, in *UpdateSaleRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(emptypb.Empty)
@@ -476,16 +892,40 @@ func (c *saleServiceClient) UnblockSale(ctx context.Context, in *BlockSaleOperat
 	return out, nil
 }
 
-// SaleServiceServer is the server API for SaleService service.
-// All implementations must embed UnimplementedSaleServiceServer
-// for forward compatibility.
-type SaleServiceServer interface {
-	InsertOne(context.Context, *InsertSaleRequest) (*InsertSaleResponse, error)
+func (c *saleServiceClient) CalculatePrice(ctx context.Context, in *CalculatePriceRequest, opts ...grpc.CallOption) (*CalculatePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CalculatePriceResponse)
+	err := c.cc.Invoke(ctx, SaleService_CalculatePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *saleServiceClient) ListSalesForProduct(ctx context.Context, in *ListSalesForProductRequest, opts ...grpc.CallOption) (*GetSalesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetSalesResponse)
+	err := c.cc.Invoke(ctx, SaleService_ListSalesForProduct_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SaleServiceServer is the server API for SaleService service.
+// All implementations must embed UnimplementedSaleServiceServer
+// for forward compatibility.
+type SaleServiceServer interface {
+	InsertOne(context.Context, *InsertSaleRequest) (*InsertSaleResponse, error)
 	Get(context.Context, *GetSalesRequest) (*GetSalesResponse, error)
 	Delete(context.Context, *DeleteSaleRequest) (*emptypb.Empty, error)
+	Restore(context.Context, *RestoreSaleRequest) (*emptypb.Empty, error)
+	Purge(context.Context, *PurgeSaleRequest) (*emptypb.Empty, error)
 	Update(context.Context, *UpdateSaleRequest) (*emptypb.Empty, error)
 	BlockSale(context.Context, *BlockSaleOperationMessage) (*emptypb.Empty, error)
 	UnblockSale(context.Context, *BlockSaleOperationMessage) (*emptypb.Empty, error)
+	CalculatePrice(context.Context, *CalculatePriceRequest) (*CalculatePriceResponse, error)
+	ListSalesForProduct(context.Context, *ListSalesForProductRequest) (*GetSalesResponse, error)
 	mustEmbedUnimplementedSaleServiceServer()
 }
 
@@ -505,6 +945,12 @@ func (UnimplementedSaleServiceServer) Get(context.Context, *GetSalesRequest) (*G
 func (UnimplementedSaleServiceServer) Delete(context.Context, *DeleteSaleRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedSaleServiceServer) Restore(context.Context, *RestoreSaleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restore not implemented")
+}
+func (UnimplementedSaleServiceServer) Purge(context.Context, *PurgeSaleRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Purge not implemented")
+}
 func (UnimplementedSaleServiceServer) Update(context.Context, *UpdateSaleRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
 }
@@ -514,6 +960,12 @@ func (UnimplementedSaleServiceServer) BlockSale(context.Context, *BlockSaleOpera
 func (UnimplementedSaleServiceServer) UnblockSale(context.Context, *BlockSaleOperationMessage) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnblockSale not implemented")
 }
+func (UnimplementedSaleServiceServer) CalculatePrice(context.Context, *CalculatePriceRequest) (*CalculatePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CalculatePrice not implemented")
+}
+func (UnimplementedSaleServiceServer) ListSalesForProduct(context.Context, *ListSalesForProductRequest) (*GetSalesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSalesForProduct not implemented")
+}
 func (UnimplementedSaleServiceServer) mustEmbedUnimplementedSaleServiceServer() {}
 func (UnimplementedSaleServiceServer) testEmbeddedByValue()                     {}
 
@@ -589,6 +1041,42 @@ func _SaleService_Delete_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SaleService_Restore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaleServiceServer).Restore(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaleService_Restore_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaleServiceServer).Restore(ctx, req.(*RestoreSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaleService_Purge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PurgeSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaleServiceServer).Purge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaleService_Purge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaleServiceServer).Purge(ctx, req.(*PurgeSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SaleService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSaleRequest)
 	if err := dec(in); err != nil {
@@ -643,6 +1131,42 @@ func _SaleService_UnblockSale_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SaleService_CalculatePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalculatePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaleServiceServer).CalculatePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaleService_CalculatePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaleServiceServer).CalculatePrice(ctx, req.(*CalculatePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SaleService_ListSalesForProduct_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSalesForProductRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SaleServiceServer).ListSalesForProduct(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SaleService_ListSalesForProduct_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SaleServiceServer).ListSalesForProduct(ctx, req.(*ListSalesForProductRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SaleService_ServiceDesc is the grpc.ServiceDesc for SaleService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -662,6 +1186,14 @@ var SaleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _SaleService_Delete_Handler,
 		},
+		{
+			MethodName: "Restore",
+			Handler:    _SaleService_Restore_Handler,
+		},
+		{
+			MethodName: "Purge",
+			Handler:    _SaleService_Purge_Handler,
+		},
 		{
 			MethodName: "Update",
 			Handler:    _SaleService_Update_Handler,
@@ -674,6 +1206,1870 @@ var SaleService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UnblockSale",
 			Handler:    _SaleService_UnblockSale_Handler,
 		},
+		{
+			MethodName: "CalculatePrice",
+			Handler:    _SaleService_CalculatePrice_Handler,
+		},
+		{
+			MethodName: "ListSalesForProduct",
+			Handler:    _SaleService_ListSalesForProduct_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iims.proto",
+}
+
+const (
+	StockService_GetStock_FullMethodName        = "/iims.StockService/GetStock"
+	StockService_AdjustStock_FullMethodName     = "/iims.StockService/AdjustStock"
+	StockService_Reserve_FullMethodName         = "/iims.StockService/Reserve"
+	StockService_Release_FullMethodName         = "/iims.StockService/Release"
+	StockService_ListMovements_FullMethodName   = "/iims.StockService/ListMovements"
+	StockService_RebuildBalances_FullMethodName = "/iims.StockService/RebuildBalances"
+)
+
+// StockServiceClient is the client API for StockService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type StockServiceClient interface {
+	GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*StockMessage, error)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*StockMessage, error)
+	Reserve(ctx context.Context, in *StockReservationRequest, opts ...grpc.CallOption) (*StockMessage, error)
+	Release(ctx context.Context, in *StockReservationRequest, opts ...grpc.CallOption) (*StockMessage, error)
+	ListMovements(ctx context.Context, in *ListMovementsRequest, opts ...grpc.CallOption) (*ListMovementsResponse, error)
+	RebuildBalances(ctx context.Context, in *RebuildBalancesRequest, opts ...grpc.CallOption) (*RebuildBalancesResponse, error)
+}
+
+type stockServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStockServiceClient(cc grpc.ClientConnInterface) StockServiceClient {
+	return &stockServiceClient{cc}
+}
+
+func (c *stockServiceClient) GetStock(ctx context.Context, in *GetStockRequest, opts ...grpc.CallOption) (*StockMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMessage)
+	err := c.cc.Invoke(ctx, StockService_GetStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*StockMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMessage)
+	err := c.cc.Invoke(ctx, StockService_AdjustStock_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) Reserve(ctx context.Context, in *StockReservationRequest, opts ...grpc.CallOption) (*StockMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMessage)
+	err := c.cc.Invoke(ctx, StockService_Reserve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) Release(ctx context.Context, in *StockReservationRequest, opts ...grpc.CallOption) (*StockMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(StockMessage)
+	err := c.cc.Invoke(ctx, StockService_Release_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) ListMovements(ctx context.Context, in *ListMovementsRequest, opts ...grpc.CallOption) (*ListMovementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMovementsResponse)
+	err := c.cc.Invoke(ctx, StockService_ListMovements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *stockServiceClient) RebuildBalances(ctx context.Context, in *RebuildBalancesRequest, opts ...grpc.CallOption) (*RebuildBalancesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RebuildBalancesResponse)
+	err := c.cc.Invoke(ctx, StockService_RebuildBalances_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StockServiceServer is the server API for StockService service.
+// All implementations must embed UnimplementedStockServiceServer
+// for forward compatibility.
+type StockServiceServer interface {
+	GetStock(context.Context, *GetStockRequest) (*StockMessage, error)
+	AdjustStock(context.Context, *AdjustStockRequest) (*StockMessage, error)
+	Reserve(context.Context, *StockReservationRequest) (*StockMessage, error)
+	Release(context.Context, *StockReservationRequest) (*StockMessage, error)
+	ListMovements(context.Context, *ListMovementsRequest) (*ListMovementsResponse, error)
+	RebuildBalances(context.Context, *RebuildBalancesRequest) (*RebuildBalancesResponse, error)
+	mustEmbedUnimplementedStockServiceServer()
+}
+
+// UnimplementedStockServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStockServiceServer struct{}
+
+func (UnimplementedStockServiceServer) GetStock(context.Context, *GetStockRequest) (*StockMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStock not implemented")
+}
+func (UnimplementedStockServiceServer) AdjustStock(context.Context, *AdjustStockRequest) (*StockMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdjustStock not implemented")
+}
+func (UnimplementedStockServiceServer) Reserve(context.Context, *StockReservationRequest) (*StockMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserve not implemented")
+}
+func (UnimplementedStockServiceServer) Release(context.Context, *StockReservationRequest) (*StockMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedStockServiceServer) ListMovements(context.Context, *ListMovementsRequest) (*ListMovementsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMovements not implemented")
+}
+func (UnimplementedStockServiceServer) RebuildBalances(context.Context, *RebuildBalancesRequest) (*RebuildBalancesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RebuildBalances not implemented")
+}
+func (UnimplementedStockServiceServer) mustEmbedUnimplementedStockServiceServer() {}
+func (UnimplementedStockServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeStockServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StockServiceServer will
+// result in compilation errors.
+type UnsafeStockServiceServer interface {
+	mustEmbedUnimplementedStockServiceServer()
+}
+
+func RegisterStockServiceServer(s grpc.ServiceRegistrar, srv StockServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStockServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StockService_ServiceDesc, srv)
+}
+
+func _StockService_GetStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).GetStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_GetStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).GetStock(ctx, req.(*GetStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).AdjustStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_AdjustStock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_Reserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).Reserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_Reserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).Reserve(ctx, req.(*StockReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StockReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_Release_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).Release(ctx, req.(*StockReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_ListMovements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMovementsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).ListMovements(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_ListMovements_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).ListMovements(ctx, req.(*ListMovementsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StockService_RebuildBalances_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebuildBalancesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StockServiceServer).RebuildBalances(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StockService_RebuildBalances_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StockServiceServer).RebuildBalances(ctx, req.(*RebuildBalancesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StockService_ServiceDesc is the grpc.ServiceDesc for StockService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StockService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iims.StockService",
+	HandlerType: (*StockServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStock",
+			Handler:    _StockService_GetStock_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _StockService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "Reserve",
+			Handler:    _StockService_Reserve_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _StockService_Release_Handler,
+		},
+		{
+			MethodName: "ListMovements",
+			Handler:    _StockService_ListMovements_Handler,
+		},
+		{
+			MethodName: "RebuildBalances",
+			Handler:    _StockService_RebuildBalances_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iims.proto",
+}
+
+const (
+	WarehouseService_InsertOne_FullMethodName        = "/iims.WarehouseService/InsertOne"
+	WarehouseService_Get_FullMethodName              = "/iims.WarehouseService/Get"
+	WarehouseService_GetById_FullMethodName          = "/iims.WarehouseService/GetById"
+	WarehouseService_Delete_FullMethodName           = "/iims.WarehouseService/Delete"
+	WarehouseService_Update_FullMethodName           = "/iims.WarehouseService/Update"
+	WarehouseService_BlockWarehouse_FullMethodName   = "/iims.WarehouseService/BlockWarehouse"
+	WarehouseService_UnblockWarehouse_FullMethodName = "/iims.WarehouseService/UnblockWarehouse"
+)
+
+// WarehouseServiceClient is the client API for WarehouseService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type WarehouseServiceClient interface {
+	InsertOne(ctx context.Context, in *InsertWarehouseRequest, opts ...grpc.CallOption) (*InsertWarehouseResponse, error)
+	Get(ctx context.Context, in *GetWarehousesRequest, opts ...grpc.CallOption) (*GetWarehousesResponse, error)
+	GetById(ctx context.Context, in *GetByIdWarehouseRequest, opts ...grpc.CallOption) (*GetWarehouseMessage, error)
+	// Delete fails while the warehouse holds stock on hand, reserved or in transit.
+	Delete(ctx context.Context, in *DeleteWarehouseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Update(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BlockWarehouse(ctx context.Context, in *BlockWarehouseOperationMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	UnblockWarehouse(ctx context.Context, in *BlockWarehouseOperationMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type warehouseServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWarehouseServiceClient(cc grpc.ClientConnInterface) WarehouseServiceClient {
+	return &warehouseServiceClient{cc}
+}
+
+func (c *warehouseServiceClient) InsertOne(ctx context.Context, in *InsertWarehouseRequest, opts ...grpc.CallOption) (*InsertWarehouseResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertWarehouseResponse)
+	err := c.cc.Invoke(ctx, WarehouseService_InsertOne_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) Get(ctx context.Context, in *GetWarehousesRequest, opts ...grpc.CallOption) (*GetWarehousesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWarehousesResponse)
+	err := c.cc.Invoke(ctx, WarehouseService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) GetById(ctx context.Context, in *GetByIdWarehouseRequest, opts ...grpc.CallOption) (*GetWarehouseMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWarehouseMessage)
+	err := c.cc.Invoke(ctx, WarehouseService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) Delete(ctx context.Context, in *DeleteWarehouseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarehouseService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) Update(ctx context.Context, in *UpdateWarehouseRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarehouseService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) BlockWarehouse(ctx context.Context, in *BlockWarehouseOperationMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarehouseService_BlockWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *warehouseServiceClient) UnblockWarehouse(ctx context.Context, in *BlockWarehouseOperationMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, WarehouseService_UnblockWarehouse_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// WarehouseServiceServer is the server API for WarehouseService service.
+// All implementations must embed UnimplementedWarehouseServiceServer
+// for forward compatibility.
+type WarehouseServiceServer interface {
+	InsertOne(context.Context, *InsertWarehouseRequest) (*InsertWarehouseResponse, error)
+	Get(context.Context, *GetWarehousesRequest) (*GetWarehousesResponse, error)
+	GetById(context.Context, *GetByIdWarehouseRequest) (*GetWarehouseMessage, error)
+	// Delete fails while the warehouse holds stock on hand, reserved or in transit.
+	Delete(context.Context, *DeleteWarehouseRequest) (*emptypb.Empty, error)
+	Update(context.Context, *UpdateWarehouseRequest) (*emptypb.Empty, error)
+	BlockWarehouse(context.Context, *BlockWarehouseOperationMessage) (*emptypb.Empty, error)
+	UnblockWarehouse(context.Context, *BlockWarehouseOperationMessage) (*emptypb.Empty, error)
+	mustEmbedUnimplementedWarehouseServiceServer()
+}
+
+// UnimplementedWarehouseServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedWarehouseServiceServer struct{}
+
+func (UnimplementedWarehouseServiceServer) InsertOne(context.Context, *InsertWarehouseRequest) (*InsertWarehouseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertOne not implemented")
+}
+func (UnimplementedWarehouseServiceServer) Get(context.Context, *GetWarehousesRequest) (*GetWarehousesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedWarehouseServiceServer) GetById(context.Context, *GetByIdWarehouseRequest) (*GetWarehouseMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedWarehouseServiceServer) Delete(context.Context, *DeleteWarehouseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedWarehouseServiceServer) Update(context.Context, *UpdateWarehouseRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedWarehouseServiceServer) BlockWarehouse(context.Context, *BlockWarehouseOperationMessage) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockWarehouse not implemented")
+}
+func (UnimplementedWarehouseServiceServer) UnblockWarehouse(context.Context, *BlockWarehouseOperationMessage) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnblockWarehouse not implemented")
+}
+func (UnimplementedWarehouseServiceServer) mustEmbedUnimplementedWarehouseServiceServer() {}
+func (UnimplementedWarehouseServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeWarehouseServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to WarehouseServiceServer will
+// result in compilation errors.
+type UnsafeWarehouseServiceServer interface {
+	mustEmbedUnimplementedWarehouseServiceServer()
+}
+
+func RegisterWarehouseServiceServer(s grpc.ServiceRegistrar, srv WarehouseServiceServer) {
+	// If the following call pancis, it indicates UnimplementedWarehouseServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&WarehouseService_ServiceDesc, srv)
+}
+
+func _WarehouseService_InsertOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).InsertOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_InsertOne_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).InsertOne(ctx, req.(*InsertWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWarehousesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).Get(ctx, req.(*GetWarehousesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).GetById(ctx, req.(*GetByIdWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).Delete(ctx, req.(*DeleteWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateWarehouseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).Update(ctx, req.(*UpdateWarehouseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_BlockWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockWarehouseOperationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).BlockWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_BlockWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).BlockWarehouse(ctx, req.(*BlockWarehouseOperationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WarehouseService_UnblockWarehouse_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockWarehouseOperationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WarehouseServiceServer).UnblockWarehouse(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WarehouseService_UnblockWarehouse_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WarehouseServiceServer).UnblockWarehouse(ctx, req.(*BlockWarehouseOperationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WarehouseService_ServiceDesc is the grpc.ServiceDesc for WarehouseService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WarehouseService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iims.WarehouseService",
+	HandlerType: (*WarehouseServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsertOne",
+			Handler:    _WarehouseService_InsertOne_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _WarehouseService_Get_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _WarehouseService_GetById_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _WarehouseService_Delete_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _WarehouseService_Update_Handler,
+		},
+		{
+			MethodName: "BlockWarehouse",
+			Handler:    _WarehouseService_BlockWarehouse_Handler,
+		},
+		{
+			MethodName: "UnblockWarehouse",
+			Handler:    _WarehouseService_UnblockWarehouse_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iims.proto",
+}
+
+const (
+	TransferService_CreateTransfer_FullMethodName  = "/iims.TransferService/CreateTransfer"
+	TransferService_ShipTransfer_FullMethodName    = "/iims.TransferService/ShipTransfer"
+	TransferService_ReceiveTransfer_FullMethodName = "/iims.TransferService/ReceiveTransfer"
+)
+
+// TransferServiceClient is the client API for TransferService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TransferServiceClient interface {
+	CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*TransferMessage, error)
+	ShipTransfer(ctx context.Context, in *TransferOperationMessage, opts ...grpc.CallOption) (*TransferMessage, error)
+	ReceiveTransfer(ctx context.Context, in *TransferOperationMessage, opts ...grpc.CallOption) (*TransferMessage, error)
+}
+
+type transferServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTransferServiceClient(cc grpc.ClientConnInterface) TransferServiceClient {
+	return &transferServiceClient{cc}
+}
+
+func (c *transferServiceClient) CreateTransfer(ctx context.Context, in *CreateTransferRequest, opts ...grpc.CallOption) (*TransferMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferMessage)
+	err := c.cc.Invoke(ctx, TransferService_CreateTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) ShipTransfer(ctx context.Context, in *TransferOperationMessage, opts ...grpc.CallOption) (*TransferMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferMessage)
+	err := c.cc.Invoke(ctx, TransferService_ShipTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *transferServiceClient) ReceiveTransfer(ctx context.Context, in *TransferOperationMessage, opts ...grpc.CallOption) (*TransferMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferMessage)
+	err := c.cc.Invoke(ctx, TransferService_ReceiveTransfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TransferServiceServer is the server API for TransferService service.
+// All implementations must embed UnimplementedTransferServiceServer
+// for forward compatibility.
+type TransferServiceServer interface {
+	CreateTransfer(context.Context, *CreateTransferRequest) (*TransferMessage, error)
+	ShipTransfer(context.Context, *TransferOperationMessage) (*TransferMessage, error)
+	ReceiveTransfer(context.Context, *TransferOperationMessage) (*TransferMessage, error)
+	mustEmbedUnimplementedTransferServiceServer()
+}
+
+// UnimplementedTransferServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTransferServiceServer struct{}
+
+func (UnimplementedTransferServiceServer) CreateTransfer(context.Context, *CreateTransferRequest) (*TransferMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTransfer not implemented")
+}
+func (UnimplementedTransferServiceServer) ShipTransfer(context.Context, *TransferOperationMessage) (*TransferMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ShipTransfer not implemented")
+}
+func (UnimplementedTransferServiceServer) ReceiveTransfer(context.Context, *TransferOperationMessage) (*TransferMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReceiveTransfer not implemented")
+}
+func (UnimplementedTransferServiceServer) mustEmbedUnimplementedTransferServiceServer() {}
+func (UnimplementedTransferServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeTransferServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TransferServiceServer will
+// result in compilation errors.
+type UnsafeTransferServiceServer interface {
+	mustEmbedUnimplementedTransferServiceServer()
+}
+
+func RegisterTransferServiceServer(s grpc.ServiceRegistrar, srv TransferServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTransferServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TransferService_ServiceDesc, srv)
+}
+
+func _TransferService_CreateTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).CreateTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_CreateTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).CreateTransfer(ctx, req.(*CreateTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_ShipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOperationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).ShipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_ShipTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).ShipTransfer(ctx, req.(*TransferOperationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TransferService_ReceiveTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferOperationMessage)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TransferServiceServer).ReceiveTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TransferService_ReceiveTransfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TransferServiceServer).ReceiveTransfer(ctx, req.(*TransferOperationMessage))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TransferService_ServiceDesc is the grpc.ServiceDesc for TransferService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TransferService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iims.TransferService",
+	HandlerType: (*TransferServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTransfer",
+			Handler:    _TransferService_CreateTransfer_Handler,
+		},
+		{
+			MethodName: "ShipTransfer",
+			Handler:    _TransferService_ShipTransfer_Handler,
+		},
+		{
+			MethodName: "ReceiveTransfer",
+			Handler:    _TransferService_ReceiveTransfer_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iims.proto",
+}
+
+const (
+	CategoryService_InsertOne_FullMethodName    = "/iims.CategoryService/InsertOne"
+	CategoryService_Get_FullMethodName          = "/iims.CategoryService/Get"
+	CategoryService_GetById_FullMethodName      = "/iims.CategoryService/GetById"
+	CategoryService_Delete_FullMethodName       = "/iims.CategoryService/Delete"
+	CategoryService_Update_FullMethodName       = "/iims.CategoryService/Update"
+	CategoryService_GetTree_FullMethodName      = "/iims.CategoryService/GetTree"
+	CategoryService_MoveCategory_FullMethodName = "/iims.CategoryService/MoveCategory"
+)
+
+// CategoryServiceClient is the client API for CategoryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CategoryServiceClient interface {
+	InsertOne(ctx context.Context, in *InsertCategoryRequest, opts ...grpc.CallOption) (*InsertCategoryResponse, error)
+	Get(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error)
+	GetById(ctx context.Context, in *GetByIdCategoryRequest, opts ...grpc.CallOption) (*GetCategoryMessage, error)
+	Delete(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Update(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type categoryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCategoryServiceClient(cc grpc.ClientConnInterface) CategoryServiceClient {
+	return &categoryServiceClient{cc}
+}
+
+func (c *categoryServiceClient) InsertOne(ctx context.Context, in *InsertCategoryRequest, opts ...grpc.CallOption) (*InsertCategoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertCategoryResponse)
+	err := c.cc.Invoke(ctx, CategoryService_InsertOne_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) Get(ctx context.Context, in *GetCategoriesRequest, opts ...grpc.CallOption) (*GetCategoriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoriesResponse)
+	err := c.cc.Invoke(ctx, CategoryService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) GetById(ctx context.Context, in *GetByIdCategoryRequest, opts ...grpc.CallOption) (*GetCategoryMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryMessage)
+	err := c.cc.Invoke(ctx, CategoryService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) Delete(ctx context.Context, in *DeleteCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CategoryService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) Update(ctx context.Context, in *UpdateCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CategoryService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) GetTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, CategoryService_GetTree_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *categoryServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, CategoryService_MoveCategory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CategoryServiceServer is the server API for CategoryService service.
+// All implementations must embed UnimplementedCategoryServiceServer
+// for forward compatibility.
+type CategoryServiceServer interface {
+	InsertOne(context.Context, *InsertCategoryRequest) (*InsertCategoryResponse, error)
+	Get(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error)
+	GetById(context.Context, *GetByIdCategoryRequest) (*GetCategoryMessage, error)
+	Delete(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error)
+	Update(context.Context, *UpdateCategoryRequest) (*emptypb.Empty, error)
+	GetTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedCategoryServiceServer()
+}
+
+// UnimplementedCategoryServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCategoryServiceServer struct{}
+
+func (UnimplementedCategoryServiceServer) InsertOne(context.Context, *InsertCategoryRequest) (*InsertCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertOne not implemented")
+}
+func (UnimplementedCategoryServiceServer) Get(context.Context, *GetCategoriesRequest) (*GetCategoriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedCategoryServiceServer) GetById(context.Context, *GetByIdCategoryRequest) (*GetCategoryMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedCategoryServiceServer) Delete(context.Context, *DeleteCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedCategoryServiceServer) Update(context.Context, *UpdateCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedCategoryServiceServer) GetTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTree not implemented")
+}
+func (UnimplementedCategoryServiceServer) MoveCategory(context.Context, *MoveCategoryRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveCategory not implemented")
+}
+func (UnimplementedCategoryServiceServer) mustEmbedUnimplementedCategoryServiceServer() {}
+func (UnimplementedCategoryServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeCategoryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CategoryServiceServer will
+// result in compilation errors.
+type UnsafeCategoryServiceServer interface {
+	mustEmbedUnimplementedCategoryServiceServer()
+}
+
+func RegisterCategoryServiceServer(s grpc.ServiceRegistrar, srv CategoryServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCategoryServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CategoryService_ServiceDesc, srv)
+}
+
+func _CategoryService_InsertOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).InsertOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_InsertOne_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).InsertOne(ctx, req.(*InsertCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).Get(ctx, req.(*GetCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).GetById(ctx, req.(*GetByIdCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).Delete(ctx, req.(*DeleteCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).Update(ctx, req.(*UpdateCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_GetTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).GetTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_GetTree_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).GetTree(ctx, req.(*GetCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CategoryService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CategoryServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CategoryService_MoveCategory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CategoryServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CategoryService_ServiceDesc is the grpc.ServiceDesc for CategoryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CategoryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iims.CategoryService",
+	HandlerType: (*CategoryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsertOne",
+			Handler:    _CategoryService_InsertOne_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CategoryService_Get_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _CategoryService_GetById_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CategoryService_Delete_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _CategoryService_Update_Handler,
+		},
+		{
+			MethodName: "GetTree",
+			Handler:    _CategoryService_GetTree_Handler,
+		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _CategoryService_MoveCategory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iims.proto",
+}
+
+const (
+	AttributeService_InsertOne_FullMethodName = "/iims.AttributeService/InsertOne"
+	AttributeService_Get_FullMethodName       = "/iims.AttributeService/Get"
+	AttributeService_Update_FullMethodName    = "/iims.AttributeService/Update"
+	AttributeService_Delete_FullMethodName    = "/iims.AttributeService/Delete"
+)
+
+// AttributeServiceClient is the client API for AttributeService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AttributeServiceClient interface {
+	InsertOne(ctx context.Context, in *InsertAttributeRequest, opts ...grpc.CallOption) (*InsertAttributeResponse, error)
+	Get(ctx context.Context, in *GetAttributesRequest, opts ...grpc.CallOption) (*GetAttributesResponse, error)
+	Update(ctx context.Context, in *UpdateAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *DeleteAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type attributeServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttributeServiceClient(cc grpc.ClientConnInterface) AttributeServiceClient {
+	return &attributeServiceClient{cc}
+}
+
+func (c *attributeServiceClient) InsertOne(ctx context.Context, in *InsertAttributeRequest, opts ...grpc.CallOption) (*InsertAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertAttributeResponse)
+	err := c.cc.Invoke(ctx, AttributeService_InsertOne_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeServiceClient) Get(ctx context.Context, in *GetAttributesRequest, opts ...grpc.CallOption) (*GetAttributesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAttributesResponse)
+	err := c.cc.Invoke(ctx, AttributeService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeServiceClient) Update(ctx context.Context, in *UpdateAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttributeService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *attributeServiceClient) Delete(ctx context.Context, in *DeleteAttributeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AttributeService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttributeServiceServer is the server API for AttributeService service.
+// All implementations must embed UnimplementedAttributeServiceServer
+// for forward compatibility.
+type AttributeServiceServer interface {
+	InsertOne(context.Context, *InsertAttributeRequest) (*InsertAttributeResponse, error)
+	Get(context.Context, *GetAttributesRequest) (*GetAttributesResponse, error)
+	Update(context.Context, *UpdateAttributeRequest) (*emptypb.Empty, error)
+	Delete(context.Context, *DeleteAttributeRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedAttributeServiceServer()
+}
+
+// UnimplementedAttributeServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttributeServiceServer struct{}
+
+func (UnimplementedAttributeServiceServer) InsertOne(context.Context, *InsertAttributeRequest) (*InsertAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertOne not implemented")
+}
+func (UnimplementedAttributeServiceServer) Get(context.Context, *GetAttributesRequest) (*GetAttributesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedAttributeServiceServer) Update(context.Context, *UpdateAttributeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedAttributeServiceServer) Delete(context.Context, *DeleteAttributeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedAttributeServiceServer) mustEmbedUnimplementedAttributeServiceServer() {}
+func (UnimplementedAttributeServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafeAttributeServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttributeServiceServer will
+// result in compilation errors.
+type UnsafeAttributeServiceServer interface {
+	mustEmbedUnimplementedAttributeServiceServer()
+}
+
+func RegisterAttributeServiceServer(s grpc.ServiceRegistrar, srv AttributeServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttributeServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttributeService_ServiceDesc, srv)
+}
+
+func _AttributeService_InsertOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServiceServer).InsertOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttributeService_InsertOne_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServiceServer).InsertOne(ctx, req.(*InsertAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttributeService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAttributesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttributeService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServiceServer).Get(ctx, req.(*GetAttributesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttributeService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttributeService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServiceServer).Update(ctx, req.(*UpdateAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AttributeService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttributeService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeServiceServer).Delete(ctx, req.(*DeleteAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttributeService_ServiceDesc is the grpc.ServiceDesc for AttributeService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttributeService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iims.AttributeService",
+	HandlerType: (*AttributeServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsertOne",
+			Handler:    _AttributeService_InsertOne_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _AttributeService_Get_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _AttributeService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _AttributeService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iims.proto",
+}
+
+const (
+	PriceListService_InsertOne_FullMethodName    = "/iims.PriceListService/InsertOne"
+	PriceListService_Get_FullMethodName          = "/iims.PriceListService/Get"
+	PriceListService_GetById_FullMethodName      = "/iims.PriceListService/GetById"
+	PriceListService_Update_FullMethodName       = "/iims.PriceListService/Update"
+	PriceListService_Delete_FullMethodName       = "/iims.PriceListService/Delete"
+	PriceListService_ResolvePrice_FullMethodName = "/iims.PriceListService/ResolvePrice"
+)
+
+// PriceListServiceClient is the client API for PriceListService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PriceListServiceClient interface {
+	InsertOne(ctx context.Context, in *InsertPriceListRequest, opts ...grpc.CallOption) (*InsertPriceListResponse, error)
+	Get(ctx context.Context, in *GetPriceListsRequest, opts ...grpc.CallOption) (*GetPriceListsResponse, error)
+	GetById(ctx context.Context, in *GetByIdPriceListRequest, opts ...grpc.CallOption) (*GetPriceListMessage, error)
+	Update(ctx context.Context, in *UpdatePriceListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	Delete(ctx context.Context, in *DeletePriceListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ResolvePrice(ctx context.Context, in *ResolvePriceRequest, opts ...grpc.CallOption) (*ResolvePriceResponse, error)
+}
+
+type priceListServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPriceListServiceClient(cc grpc.ClientConnInterface) PriceListServiceClient {
+	return &priceListServiceClient{cc}
+}
+
+func (c *priceListServiceClient) InsertOne(ctx context.Context, in *InsertPriceListRequest, opts ...grpc.CallOption) (*InsertPriceListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertPriceListResponse)
+	err := c.cc.Invoke(ctx, PriceListService_InsertOne_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) Get(ctx context.Context, in *GetPriceListsRequest, opts ...grpc.CallOption) (*GetPriceListsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceListsResponse)
+	err := c.cc.Invoke(ctx, PriceListService_Get_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) GetById(ctx context.Context, in *GetByIdPriceListRequest, opts ...grpc.CallOption) (*GetPriceListMessage, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPriceListMessage)
+	err := c.cc.Invoke(ctx, PriceListService_GetById_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) Update(ctx context.Context, in *UpdatePriceListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PriceListService_Update_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) Delete(ctx context.Context, in *DeletePriceListRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PriceListService_Delete_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *priceListServiceClient) ResolvePrice(ctx context.Context, in *ResolvePriceRequest, opts ...grpc.CallOption) (*ResolvePriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResolvePriceResponse)
+	err := c.cc.Invoke(ctx, PriceListService_ResolvePrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PriceListServiceServer is the server API for PriceListService service.
+// All implementations must embed UnimplementedPriceListServiceServer
+// for forward compatibility.
+type PriceListServiceServer interface {
+	InsertOne(context.Context, *InsertPriceListRequest) (*InsertPriceListResponse, error)
+	Get(context.Context, *GetPriceListsRequest) (*GetPriceListsResponse, error)
+	GetById(context.Context, *GetByIdPriceListRequest) (*GetPriceListMessage, error)
+	Update(context.Context, *UpdatePriceListRequest) (*emptypb.Empty, error)
+	Delete(context.Context, *DeletePriceListRequest) (*emptypb.Empty, error)
+	ResolvePrice(context.Context, *ResolvePriceRequest) (*ResolvePriceResponse, error)
+	mustEmbedUnimplementedPriceListServiceServer()
+}
+
+// UnimplementedPriceListServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPriceListServiceServer struct{}
+
+func (UnimplementedPriceListServiceServer) InsertOne(context.Context, *InsertPriceListRequest) (*InsertPriceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertOne not implemented")
+}
+func (UnimplementedPriceListServiceServer) Get(context.Context, *GetPriceListsRequest) (*GetPriceListsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (UnimplementedPriceListServiceServer) GetById(context.Context, *GetByIdPriceListRequest) (*GetPriceListMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetById not implemented")
+}
+func (UnimplementedPriceListServiceServer) Update(context.Context, *UpdatePriceListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedPriceListServiceServer) Delete(context.Context, *DeletePriceListRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (UnimplementedPriceListServiceServer) ResolvePrice(context.Context, *ResolvePriceRequest) (*ResolvePriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePrice not implemented")
+}
+func (UnimplementedPriceListServiceServer) mustEmbedUnimplementedPriceListServiceServer() {}
+func (UnimplementedPriceListServiceServer) testEmbeddedByValue()                          {}
+
+// UnsafePriceListServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PriceListServiceServer will
+// result in compilation errors.
+type UnsafePriceListServiceServer interface {
+	mustEmbedUnimplementedPriceListServiceServer()
+}
+
+func RegisterPriceListServiceServer(s grpc.ServiceRegistrar, srv PriceListServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPriceListServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PriceListService_ServiceDesc, srv)
+}
+
+func _PriceListService_InsertOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertPriceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).InsertOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceListService_InsertOne_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).InsertOne(ctx, req.(*InsertPriceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceListService_Get_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).Get(ctx, req.(*GetPriceListsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_GetById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetByIdPriceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).GetById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceListService_GetById_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).GetById(ctx, req.(*GetByIdPriceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePriceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceListService_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).Update(ctx, req.(*UpdatePriceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePriceListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceListService_Delete_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).Delete(ctx, req.(*DeletePriceListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PriceListService_ResolvePrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolvePriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PriceListServiceServer).ResolvePrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PriceListService_ResolvePrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PriceListServiceServer).ResolvePrice(ctx, req.(*ResolvePriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PriceListService_ServiceDesc is the grpc.ServiceDesc for PriceListService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PriceListService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iims.PriceListService",
+	HandlerType: (*PriceListServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsertOne",
+			Handler:    _PriceListService_InsertOne_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _PriceListService_Get_Handler,
+		},
+		{
+			MethodName: "GetById",
+			Handler:    _PriceListService_GetById_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _PriceListService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _PriceListService_Delete_Handler,
+		},
+		{
+			MethodName: "ResolvePrice",
+			Handler:    _PriceListService_ResolvePrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "iims.proto",
+}
+
+const (
+	CouponService_InsertOne_FullMethodName      = "/iims.CouponService/InsertOne"
+	CouponService_Generate_FullMethodName       = "/iims.CouponService/Generate"
+	CouponService_ValidateCoupon_FullMethodName = "/iims.CouponService/ValidateCoupon"
+	CouponService_RedeemCoupon_FullMethodName   = "/iims.CouponService/RedeemCoupon"
+)
+
+// CouponServiceClient is the client API for CouponService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type CouponServiceClient interface {
+	InsertOne(ctx context.Context, in *InsertCouponRequest, opts ...grpc.CallOption) (*InsertCouponResponse, error)
+	Generate(ctx context.Context, in *GenerateCouponsRequest, opts ...grpc.CallOption) (*GenerateCouponsResponse, error)
+	ValidateCoupon(ctx context.Context, in *CouponCodeRequest, opts ...grpc.CallOption) (*ValidateCouponResponse, error)
+	RedeemCoupon(ctx context.Context, in *CouponCodeRequest, opts ...grpc.CallOption) (*RedeemCouponResponse, error)
+}
+
+type couponServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewCouponServiceClient(cc grpc.ClientConnInterface) CouponServiceClient {
+	return &couponServiceClient{cc}
+}
+
+func (c *couponServiceClient) InsertOne(ctx context.Context, in *InsertCouponRequest, opts ...grpc.CallOption) (*InsertCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(InsertCouponResponse)
+	err := c.cc.Invoke(ctx, CouponService_InsertOne_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) Generate(ctx context.Context, in *GenerateCouponsRequest, opts ...grpc.CallOption) (*GenerateCouponsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GenerateCouponsResponse)
+	err := c.cc.Invoke(ctx, CouponService_Generate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) ValidateCoupon(ctx context.Context, in *CouponCodeRequest, opts ...grpc.CallOption) (*ValidateCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ValidateCouponResponse)
+	err := c.cc.Invoke(ctx, CouponService_ValidateCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) RedeemCoupon(ctx context.Context, in *CouponCodeRequest, opts ...grpc.CallOption) (*RedeemCouponResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeemCouponResponse)
+	err := c.cc.Invoke(ctx, CouponService_RedeemCoupon_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CouponServiceServer is the server API for CouponService service.
+// All implementations must embed UnimplementedCouponServiceServer
+// for forward compatibility.
+type CouponServiceServer interface {
+	InsertOne(context.Context, *InsertCouponRequest) (*InsertCouponResponse, error)
+	Generate(context.Context, *GenerateCouponsRequest) (*GenerateCouponsResponse, error)
+	ValidateCoupon(context.Context, *CouponCodeRequest) (*ValidateCouponResponse, error)
+	RedeemCoupon(context.Context, *CouponCodeRequest) (*RedeemCouponResponse, error)
+	mustEmbedUnimplementedCouponServiceServer()
+}
+
+// UnimplementedCouponServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedCouponServiceServer struct{}
+
+func (UnimplementedCouponServiceServer) InsertOne(context.Context, *InsertCouponRequest) (*InsertCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsertOne not implemented")
+}
+func (UnimplementedCouponServiceServer) Generate(context.Context, *GenerateCouponsRequest) (*GenerateCouponsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Generate not implemented")
+}
+func (UnimplementedCouponServiceServer) ValidateCoupon(context.Context, *CouponCodeRequest) (*ValidateCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCoupon not implemented")
+}
+func (UnimplementedCouponServiceServer) RedeemCoupon(context.Context, *CouponCodeRequest) (*RedeemCouponResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemCoupon not implemented")
+}
+func (UnimplementedCouponServiceServer) mustEmbedUnimplementedCouponServiceServer() {}
+func (UnimplementedCouponServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeCouponServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to CouponServiceServer will
+// result in compilation errors.
+type UnsafeCouponServiceServer interface {
+	mustEmbedUnimplementedCouponServiceServer()
+}
+
+func RegisterCouponServiceServer(s grpc.ServiceRegistrar, srv CouponServiceServer) {
+	// If the following call pancis, it indicates UnimplementedCouponServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&CouponService_ServiceDesc, srv)
+}
+
+func _CouponService_InsertOne_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InsertCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).InsertOne(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_InsertOne_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).InsertOne(ctx, req.(*InsertCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_Generate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).Generate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_Generate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).Generate(ctx, req.(*GenerateCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_ValidateCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).ValidateCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_ValidateCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).ValidateCoupon(ctx, req.(*CouponCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_RedeemCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CouponCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).RedeemCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CouponService_RedeemCoupon_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).RedeemCoupon(ctx, req.(*CouponCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// CouponService_ServiceDesc is the grpc.ServiceDesc for CouponService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var CouponService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "iims.CouponService",
+	HandlerType: (*CouponServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "InsertOne",
+			Handler:    _CouponService_InsertOne_Handler,
+		},
+		{
+			MethodName: "Generate",
+			Handler:    _CouponService_Generate_Handler,
+		},
+		{
+			MethodName: "ValidateCoupon",
+			Handler:    _CouponService_ValidateCoupon_Handler,
+		},
+		{
+			MethodName: "RedeemCoupon",
+			Handler:    _CouponService_RedeemCoupon_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "iims.proto",
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+)
+
+const (
+	AttributeCollection = "attribute_definitions"
+)
+
+type AttributeRepository interface {
+	InsertOne(context.Context, *models.AttributeDefinition) (string, error)
+	GetById(context.Context, string) (models.AttributeDefinition, error)
+	GetByCategories(context.Context, []string) ([]models.AttributeDefinition, error)
+	Update(context.Context, *models.AttributeDefinition) error
+	Delete(context.Context, string) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+)
+
+const (
+	CategoryCollection = "categories"
+)
+
+type CategoryRepository interface {
+	InsertOne(context.Context, *models.Category) (string, error)
+	Get(context.Context, int64, int64) ([]models.Category, error)
+	GetById(context.Context, string) (models.Category, error)
+	GetSubtree(context.Context, string) ([]models.Category, error)
+	Update(context.Context, *models.Category) error
+	Delete(context.Context, string) error
+	Move(context.Context, string, string, []string) error
+}
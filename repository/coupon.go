@@ -0,0 +1,24 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"time"
+)
+
+const (
+	CouponCollection           = "coupons"
+	CouponRedemptionCollection = "coupon_redemptions"
+)
+
+type CouponRepository interface {
+	InsertOne(context.Context, *models.Coupon) (string, error)
+	// InsertMany inserts the coupons whose codes are free and returns the codes that were taken.
+	InsertMany(context.Context, []models.Coupon) ([]string, error)
+	GetByCode(context.Context, string) (models.Coupon, error)
+	GetCustomerRedemptions(context.Context, string, string) (int64, error)
+	// Redeem counts one redemption of the coupon by the customer at the time given. It fails with
+	// ErrRedemptionLimit when the coupon is used up or expired and with ErrCustomerRedemptionLimit
+	// when the customer has used up their share; nothing is counted then.
+	Redeem(context.Context, models.Coupon, string, time.Time) (models.Coupon, error)
+}
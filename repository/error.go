@@ -1,7 +1,16 @@
 package repository
 
-import "errors"
+import "github.com/igntnk/stocky_iims/models"
 
 var (
-	ErrEntityNotFound = errors.New("entity not found")
+	ErrEntityNotFound          = models.NewError(models.ErrNotFound, "entity not found")
+	ErrInvalidId               = models.NewError(models.ErrInvalidArgument, "invalid id")
+	ErrAlreadyExists           = models.NewError(models.ErrConflict, "entity already exists")
+	ErrInsufficientStock       = models.NewError(models.ErrPreconditionFailed, "insufficient stock")
+	ErrInsufficientReserved    = models.NewError(models.ErrPreconditionFailed, "insufficient reserved stock")
+	ErrTransferStatus          = models.NewError(models.ErrPreconditionFailed, "transfer is not in the required status")
+	ErrInvalidPageToken        = models.NewError(models.ErrInvalidArgument, "invalid page token")
+	ErrCurrencyChanged         = models.NewError(models.ErrPreconditionFailed, "price is not in the product currency")
+	ErrRedemptionLimit         = models.NewError(models.ErrPreconditionFailed, "coupon redemption limit reached")
+	ErrCustomerRedemptionLimit = models.NewError(models.ErrPreconditionFailed, "customer coupon redemption limit reached")
 )
@@ -0,0 +1,113 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type attributeRepository struct {
+	Logger              zerolog.Logger
+	AttributeCollection *mongo.Collection
+	Tx                  Tx
+}
+
+func NewAttributeRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.AttributeRepository {
+	return &attributeRepository{
+		Logger:              logger.With().Str("repository", repository.AttributeCollection).Logger(),
+		AttributeCollection: database.Collection(repository.AttributeCollection),
+		Tx:                  txImpl,
+	}
+}
+
+func (r *attributeRepository) InsertOne(ctx context.Context, definition *models.AttributeDefinition) (string, error) {
+	res, err := r.AttributeCollection.InsertOne(ctx, definition)
+	if mongo.IsDuplicateKeyError(err) {
+		return "", repository.ErrAlreadyExists
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *attributeRepository) GetById(ctx context.Context, id string) (models.AttributeDefinition, error) {
+	definition := models.AttributeDefinition{}
+
+	idObj, err := objectId(id)
+	if err != nil {
+		return definition, err
+	}
+
+	err = r.AttributeCollection.FindOne(ctx, bson.M{"_id": idObj}).Decode(&definition)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return definition, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return definition, err
+	}
+
+	return definition, nil
+}
+
+// GetByCategories returns the definitions bound to any of the categories, ordered by name.
+func (r *attributeRepository) GetByCategories(ctx context.Context, categoryIds []string) ([]models.AttributeDefinition, error) {
+	definitions := []models.AttributeDefinition{}
+
+	opts := options.Find().SetSort(bson.D{{Key: "name", Value: 1}})
+	res, err := r.AttributeCollection.Find(ctx, bson.M{"category_id": bson.M{"$in": categoryIds}}, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &definitions)
+	if err != nil {
+		return nil, err
+	}
+
+	return definitions, nil
+}
+
+func (r *attributeRepository) Update(ctx context.Context, definition *models.AttributeDefinition) error {
+	id, err := objectId(definition.Id)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.AttributeCollection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{
+		"required":       definition.Required,
+		"allowed_values": definition.AllowedValues,
+	}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *attributeRepository) Delete(ctx context.Context, id string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.AttributeCollection.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package mongo
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+// blockUpdate blocks a document on behalf of actor for reason. Blocking again replaces the
+// actor, time and reason of the earlier block.
+func blockUpdate(actor, reason string) bson.M {
+	set := bson.M{"blocked": true, "blocked_at": time.Now()}
+	unset := bson.M{}
+	if actor != "" {
+		set["blocked_by"] = actor
+	} else {
+		unset["blocked_by"] = ""
+	}
+	if reason != "" {
+		set["blocked_reason"] = reason
+	} else {
+		unset["blocked_reason"] = ""
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	return update
+}
+
+func unblockUpdate() bson.M {
+	return bson.M{
+		"$set":   bson.M{"blocked": false},
+		"$unset": bson.M{"blocked_by": "", "blocked_at": "", "blocked_reason": ""},
+	}
+}
+
+// setBlocked applies a block or unblock update to a document that is not deleted.
+func setBlocked(ctx context.Context, collection *mongo.Collection, id string, update bson.M) error {
+	filter, err := byId(id, false)
+	if err != nil {
+		return err
+	}
+
+	res, err := collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package mongo
+
+import (
+	"go.mongodb.org/mongo-driver/bson"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestBlockUpdate(t *testing.T) {
+	tests := []struct {
+		name   string
+		actor  string
+		reason string
+		set    bson.M
+		unset  bson.M
+	}{
+		{
+			name:   "actor and reason",
+			actor:  "admin",
+			reason: "recall",
+			set:    bson.M{"blocked": true, "blocked_by": "admin", "blocked_reason": "recall"},
+		},
+		{
+			name:  "actor only",
+			actor: "admin",
+			set:   bson.M{"blocked": true, "blocked_by": "admin"},
+			unset: bson.M{"blocked_reason": ""},
+		},
+		{
+			name:   "reason only",
+			reason: "recall",
+			set:    bson.M{"blocked": true, "blocked_reason": "recall"},
+			unset:  bson.M{"blocked_by": ""},
+		},
+		{
+			name:  "neither",
+			set:   bson.M{"blocked": true},
+			unset: bson.M{"blocked_by": "", "blocked_reason": ""},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			update := blockUpdate(test.actor, test.reason)
+
+			set := update["$set"].(bson.M)
+			if _, ok := set["blocked_at"].(time.Time); !ok {
+				t.Errorf("blocked_at = %v, want a time", set["blocked_at"])
+			}
+			delete(set, "blocked_at")
+			if !reflect.DeepEqual(set, test.set) {
+				t.Errorf("$set = %v, want %v", set, test.set)
+			}
+
+			unset, _ := update["$unset"].(bson.M)
+			if len(unset) != len(test.unset) || (len(unset) > 0 && !reflect.DeepEqual(unset, test.unset)) {
+				t.Errorf("$unset = %v, want %v", unset, test.unset)
+			}
+		})
+	}
+}
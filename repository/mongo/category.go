@@ -0,0 +1,183 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type categoryRepository struct {
+	Logger             zerolog.Logger
+	Client             *mongo.Client
+	CategoryCollection *mongo.Collection
+	Tx                 Tx
+}
+
+func NewCategoryRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.CategoryRepository {
+	return &categoryRepository{
+		Logger:             logger.With().Str("repository", repository.CategoryCollection).Logger(),
+		Client:             database.Client(),
+		CategoryCollection: database.Collection(repository.CategoryCollection),
+		Tx:                 txImpl,
+	}
+}
+
+func (r *categoryRepository) InsertOne(ctx context.Context, category *models.Category) (string, error) {
+	if category.Ancestors == nil {
+		category.Ancestors = []string{}
+	}
+
+	res, err := r.CategoryCollection.InsertOne(ctx, category)
+	if err != nil {
+		return "", err
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *categoryRepository) Get(ctx context.Context, limit, offset int64) ([]models.Category, error) {
+	categories := []models.Category{}
+	pipeline := getPipeline(limit, offset)
+	res, err := r.CategoryCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &categories)
+	if err != nil {
+		return nil, err
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) GetById(ctx context.Context, id string) (models.Category, error) {
+	category := models.Category{}
+
+	idObj, err := objectId(id)
+	if err != nil {
+		return category, err
+	}
+
+	err = r.CategoryCollection.FindOne(ctx, bson.M{"_id": idObj}).Decode(&category)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return category, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return category, err
+	}
+
+	return category, nil
+}
+
+// GetSubtree returns the category with the given id and all of its descendants.
+// An empty id returns the whole tree.
+func (r *categoryRepository) GetSubtree(ctx context.Context, id string) ([]models.Category, error) {
+	categories := []models.Category{}
+
+	filter := bson.M{}
+	if id != "" {
+		idObj, err := objectId(id)
+		if err != nil {
+			return nil, err
+		}
+		filter = bson.M{"$or": bson.A{bson.M{"_id": idObj}, bson.M{"ancestors": id}}}
+	}
+
+	res, err := r.CategoryCollection.Find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &categories)
+	if err != nil {
+		return nil, err
+	}
+	if id != "" && len(categories) == 0 {
+		return nil, repository.ErrEntityNotFound
+	}
+
+	return categories, nil
+}
+
+func (r *categoryRepository) Update(ctx context.Context, category *models.Category) error {
+	id, err := objectId(category.Id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.CategoryCollection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{
+		"name":        category.Name,
+		"description": category.Description,
+	}})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *categoryRepository) Delete(ctx context.Context, id string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.CategoryCollection.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// Move re-parents a category and rewrites the ancestor paths of its whole subtree in one transaction.
+func (r *categoryRepository) Move(ctx context.Context, id, parentId string, ancestors []string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+
+	set := bson.M{"ancestors": ancestors}
+	update := bson.M{"$set": set}
+	if parentId == "" {
+		update["$unset"] = bson.M{"parent_id": ""}
+	} else {
+		set["parent_id"] = parentId
+	}
+
+	_, err = r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		res, err := r.CategoryCollection.UpdateOne(ctx, bson.M{"_id": idObj}, update)
+		if err != nil {
+			return nil, err
+		}
+		if res.MatchedCount == 0 {
+			return nil, repository.ErrEntityNotFound
+		}
+
+		// Descendants keep the part of their path below the moved category.
+		prefix := append(append([]string{}, ancestors...), id)
+		_, err = r.CategoryCollection.UpdateMany(ctx, bson.M{"ancestors": id}, mongo.Pipeline{
+			{{Key: "$set", Value: bson.M{"ancestors": bson.M{"$concatArrays": bson.A{
+				prefix,
+				bson.M{"$slice": bson.A{
+					"$ancestors",
+					bson.M{"$add": bson.A{bson.M{"$indexOfArray": bson.A{"$ancestors", id}}, 1}},
+					bson.M{"$size": "$ancestors"},
+				}},
+			}}}}},
+		})
+		return nil, err
+	}, r.Logger)
+
+	return err
+}
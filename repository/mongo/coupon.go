@@ -0,0 +1,219 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type couponRepository struct {
+	Logger               zerolog.Logger
+	Client               *mongo.Client
+	CouponCollection     *mongo.Collection
+	RedemptionCollection *mongo.Collection
+	Tx                   Tx
+}
+
+func NewCouponRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.CouponRepository {
+	return &couponRepository{
+		Logger:               logger.With().Str("repository", repository.CouponCollection).Logger(),
+		Client:               database.Client(),
+		CouponCollection:     database.Collection(repository.CouponCollection),
+		RedemptionCollection: database.Collection(repository.CouponRedemptionCollection),
+		Tx:                   txImpl,
+	}
+}
+
+func (r *couponRepository) InsertOne(ctx context.Context, coupon *models.Coupon) (string, error) {
+	res, err := r.CouponCollection.InsertOne(ctx, coupon)
+	if mongo.IsDuplicateKeyError(err) {
+		return "", repository.ErrAlreadyExists
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *couponRepository) InsertMany(ctx context.Context, coupons []models.Coupon) ([]string, error) {
+	documents := make([]any, len(coupons))
+	for i, coupon := range coupons {
+		documents[i] = coupon
+	}
+
+	_, err := r.CouponCollection.InsertMany(ctx, documents, options.InsertMany().SetOrdered(false))
+	if err == nil {
+		return nil, nil
+	}
+
+	var bulkErr mongo.BulkWriteException
+	if !errors.As(err, &bulkErr) || bulkErr.WriteConcernError != nil {
+		return nil, err
+	}
+
+	taken := []string{}
+	for _, writeErr := range bulkErr.WriteErrors {
+		if !mongo.IsDuplicateKeyError(writeErr) {
+			return nil, err
+		}
+		taken = append(taken, coupons[writeErr.Index].Code)
+	}
+
+	return taken, nil
+}
+
+func (r *couponRepository) GetByCode(ctx context.Context, code string) (models.Coupon, error) {
+	coupon := models.Coupon{}
+
+	err := r.CouponCollection.FindOne(ctx, bson.M{"code": code}).Decode(&coupon)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return coupon, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return coupon, err
+	}
+
+	return coupon, nil
+}
+
+func (r *couponRepository) GetCustomerRedemptions(ctx context.Context, couponId, customerId string) (int64, error) {
+	redemption := models.CouponRedemption{}
+
+	err := r.RedemptionCollection.FindOne(ctx, bson.M{"coupon_id": couponId, "customer_id": customerId}).Decode(&redemption)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return redemption.Redemptions, nil
+}
+
+// Redeem takes the redemption with a conditional $inc, so concurrent checkouts cannot go past
+// the limits. When the customer share is used up, the total is given back; without transactions
+// the total may briefly count a redemption that is then refused.
+func (r *couponRepository) Redeem(ctx context.Context, coupon models.Coupon, customerId string, at time.Time) (models.Coupon, error) {
+	id, err := objectId(coupon.Id)
+	if err != nil {
+		return coupon, err
+	}
+
+	if customerId != "" {
+		if err = r.ensureRedemption(ctx, coupon.Id, customerId); err != nil {
+			return coupon, err
+		}
+	}
+
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		redeemed := models.Coupon{}
+
+		filter := bson.M{
+			"_id": id,
+			"$or": bson.A{bson.M{"expires_at": nil}, bson.M{"expires_at": bson.M{"$gt": at}}},
+			"$expr": bson.M{"$or": bson.A{
+				bson.M{"$eq": bson.A{"$max_redemptions", 0}},
+				bson.M{"$lt": bson.A{"$redemptions", "$max_redemptions"}},
+			}},
+		}
+		opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+		err := r.CouponCollection.FindOneAndUpdate(ctx, filter, bson.M{"$inc": bson.M{"redemptions": 1}}, opts).Decode(&redeemed)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.ErrRedemptionLimit
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if customerId == "" {
+			return redeemed, nil
+		}
+
+		customerFilter := bson.M{"coupon_id": coupon.Id, "customer_id": customerId}
+		if redeemed.MaxPerCustomer > 0 {
+			customerFilter["redemptions"] = bson.M{"$lt": redeemed.MaxPerCustomer}
+		}
+		counted, err := r.RedemptionCollection.UpdateOne(ctx, customerFilter, bson.M{
+			"$inc": bson.M{"redemptions": 1},
+			"$set": bson.M{"last_redeemed_at": at},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if counted.MatchedCount == 0 {
+			_, err = r.CouponCollection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$inc": bson.M{"redemptions": -1}})
+			if err != nil {
+				return nil, err
+			}
+			return nil, repository.ErrCustomerRedemptionLimit
+		}
+
+		return redeemed, nil
+	}, r.Logger)
+	if err != nil {
+		return coupon, err
+	}
+
+	return res.(models.Coupon), nil
+}
+
+// ensureRedemption creates the customer's redemption counter of the coupon if there is none yet.
+// It runs ahead of the redeem transaction, where a failed write would abort it. Two first
+// redemptions racing on the upsert make one of them fail on the unique key; by then the counter
+// exists, so a second try finds it.
+func (r *couponRepository) ensureRedemption(ctx context.Context, couponId, customerId string) error {
+	key := bson.M{"coupon_id": couponId, "customer_id": customerId}
+	upsert := func() error {
+		_, err := r.RedemptionCollection.UpdateOne(ctx, key,
+			bson.M{"$setOnInsert": bson.M{"redemptions": 0}},
+			options.Update().SetUpsert(true))
+		return err
+	}
+
+	err := upsert()
+	if mongo.IsDuplicateKeyError(err) {
+		err = upsert()
+	}
+
+	return err
+}
+
+// deleteSaleCoupons removes the coupons of the sales and the customer redemption counters kept
+// for them.
+func deleteSaleCoupons(ctx context.Context, coupons, redemptions *mongo.Collection, saleIds []string) error {
+	filter := bson.M{"sale_id": bson.M{"$in": saleIds}}
+
+	documents := []struct {
+		Id primitive.ObjectID `bson:"_id"`
+	}{}
+	res, err := coupons.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return err
+	}
+	if err = res.All(ctx, &documents); err != nil {
+		return err
+	}
+	if len(documents) == 0 {
+		return nil
+	}
+
+	couponIds := make([]string, len(documents))
+	for i, document := range documents {
+		couponIds[i] = document.Id.Hex()
+	}
+
+	if _, err = redemptions.DeleteMany(ctx, bson.M{"coupon_id": bson.M{"$in": couponIds}}); err != nil {
+		return err
+	}
+	_, err = coupons.DeleteMany(ctx, filter)
+	return err
+}
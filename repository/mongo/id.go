@@ -0,0 +1,42 @@
+package mongo
+
+import (
+	"fmt"
+	"github.com/igntnk/stocky_iims/repository"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// objectId parses a document id given by a client.
+func objectId(id string) (primitive.ObjectID, error) {
+	result, err := primitive.ObjectIDFromHex(id)
+	if err != nil {
+		return result, fmt.Errorf("%w: %q", repository.ErrInvalidId, id)
+	}
+
+	return result, nil
+}
+
+func objectIds(ids []string) ([]primitive.ObjectID, error) {
+	result := make([]primitive.ObjectID, len(ids))
+	for i, id := range ids {
+		var err error
+		if result[i], err = objectId(id); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func hexIds(ids []primitive.ObjectID) []string {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	result := make([]string, len(ids))
+	for i, id := range ids {
+		result[i] = id.Hex()
+	}
+
+	return result
+}
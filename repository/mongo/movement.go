@@ -0,0 +1,81 @@
+package mongo
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+type movementRepository struct {
+	Logger             zerolog.Logger
+	MovementCollection *mongo.Collection
+	Tx                 Tx
+}
+
+func NewMovementRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.MovementRepository {
+	return &movementRepository{
+		Logger:             logger.With().Str("repository", repository.MovementCollection).Logger(),
+		MovementCollection: database.Collection(repository.MovementCollection),
+		Tx:                 txImpl,
+	}
+}
+
+// insertMovement appends an entry to the ledger. It is called from the repositories that change
+// stock quantities, inside the same transaction as the balance update.
+func insertMovement(ctx context.Context, collection *mongo.Collection, movement *models.StockMovement) error {
+	if movement.CreatedAt.IsZero() {
+		movement.CreatedAt = time.Now()
+	}
+
+	_, err := collection.InsertOne(ctx, movement)
+	return err
+}
+
+func (r *movementRepository) Get(ctx context.Context, filter models.MovementFilter, limit, offset int64) ([]models.StockMovement, error) {
+	movements := []models.StockMovement{}
+
+	if _, err := objectId(filter.ProductId); err != nil {
+		return nil, err
+	}
+
+	match := bson.M{"product_id": filter.ProductId}
+	if filter.WarehouseId != "" {
+		match["warehouse_id"] = filter.WarehouseId
+	}
+	if filter.VariantId != "" {
+		match["variant_id"] = filter.VariantId
+	}
+	if filter.From != nil || filter.To != nil {
+		createdAt := bson.M{}
+		if filter.From != nil {
+			createdAt["$gte"] = *filter.From
+		}
+		if filter.To != nil {
+			createdAt["$lt"] = *filter.To
+		}
+		match["created_at"] = createdAt
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$sort", Value: bson.D{{Key: "created_at", Value: 1}, {Key: "_id", Value: 1}}}},
+	}
+	pipeline = append(pipeline, getPipeline(limit, offset)...)
+
+	res, err := r.MovementCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &movements)
+	if err != nil {
+		return nil, err
+	}
+
+	return movements, nil
+}
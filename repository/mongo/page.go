@@ -0,0 +1,200 @@
+package mongo
+
+import (
+	"context"
+	"encoding/base64"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/mongo"
+	"strings"
+)
+
+// pageToken is the position of the last item of a page in the listing order.
+type pageToken struct {
+	Field string         `bson:"f,omitempty"`
+	Value *bson.RawValue `bson:"v,omitempty"`
+	Id    bson.RawValue  `bson:"i"`
+}
+
+func encodePageToken(token pageToken) (string, error) {
+	data, err := bson.Marshal(token)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(s string) (pageToken, error) {
+	token := pageToken{}
+
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return token, repository.ErrInvalidPageToken
+	}
+	if err = bson.Unmarshal(data, &token); err != nil {
+		return token, repository.ErrInvalidPageToken
+	}
+
+	return token, nil
+}
+
+// keysetMatch matches the documents that follow the token position when ordered by the token
+// field and then by _id. Documents without the field sort before any value, so they come last
+// in descending order and follow every token with a value.
+func keysetMatch(token pageToken, descending bool) bson.M {
+	op := "$gt"
+	if descending {
+		op = "$lt"
+	}
+
+	if token.Field == "" {
+		return bson.M{"_id": bson.M{op: token.Id}}
+	}
+
+	after := bson.A{bson.M{token.Field: bson.M{op: token.Value}}}
+	if token.Value == nil || token.Value.Type == bsontype.Null {
+		if descending {
+			return bson.M{token.Field: nil, "_id": bson.M{op: token.Id}}
+		}
+		after = bson.A{bson.M{token.Field: bson.M{"$ne": nil}}}
+	} else if descending {
+		after = append(after, bson.M{token.Field: nil})
+	}
+
+	return bson.M{"$or": append(after, bson.M{token.Field: token.Value, "_id": bson.M{op: token.Id}})}
+}
+
+// pageLimit is the page size to read: the default when none is asked for, and never more than
+// the maximum.
+func pageLimit(limit int64) int64 {
+	if limit <= 0 {
+		return models.DefaultPageLimit
+	}
+	return min(limit, models.MaxPageLimit)
+}
+
+// paginate returns one page of the documents matching match, ordered by sortField and _id.
+// The page is read by a plain pipeline so that the match and the sort can use an index. When the
+// total is asked for, the page and the count over the whole match are read in one $facet, so
+// that both see the same documents.
+func paginate[T any](ctx context.Context, collection *mongo.Collection, match bson.M, sortField string, descending bool, page models.PageRequest) ([]T, models.PageInfo, error) {
+	info := models.PageInfo{}
+	limit := pageLimit(page.Limit)
+
+	direction := 1
+	if descending {
+		direction = -1
+	}
+	sort := bson.D{}
+	if sortField != "" {
+		sort = append(sort, bson.E{Key: sortField, Value: direction})
+	}
+	sort = append(sort, bson.E{Key: "_id", Value: direction})
+
+	var keyset bson.M
+	if page.Token != "" {
+		token, err := decodePageToken(page.Token)
+		if err != nil {
+			return nil, info, err
+		}
+		if token.Field != sortField {
+			return nil, info, repository.ErrInvalidPageToken
+		}
+		keyset = keysetMatch(token, descending)
+	}
+
+	// Inside the $facet the whole match has already been applied, so the rows only skip to the
+	// token position.
+	rowMatch := keyset
+	if !page.IncludeTotal {
+		rowMatch = match
+		if keyset != nil {
+			rowMatch = keyset
+			if len(match) > 0 {
+				rowMatch = bson.M{"$and": bson.A{match, keyset}}
+			}
+		}
+	}
+
+	rows := mongo.Pipeline{}
+	if len(rowMatch) > 0 {
+		rows = append(rows, bson.D{{Key: "$match", Value: rowMatch}})
+	}
+	rows = append(rows, bson.D{{Key: "$sort", Value: sort}})
+	if page.Token == "" && page.Offset > 0 {
+		rows = append(rows, bson.D{{Key: "$skip", Value: page.Offset}})
+	}
+	// One extra document tells whether another page follows.
+	rows = append(rows, bson.D{{Key: "$limit", Value: limit + 1}})
+
+	pipeline := rows
+	if page.IncludeTotal {
+		pipeline = mongo.Pipeline{}
+		if len(match) > 0 {
+			pipeline = append(pipeline, bson.D{{Key: "$match", Value: match}})
+		}
+		pipeline = append(pipeline, bson.D{{Key: "$facet", Value: bson.M{
+			"rows":  rows,
+			"total": bson.A{bson.M{"$count": "count"}},
+		}}})
+	}
+
+	res, err := collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, info, err
+	}
+	defer res.Close(ctx)
+
+	raw := []bson.Raw{}
+	if page.IncludeTotal {
+		facets := []struct {
+			Rows  []bson.Raw `bson:"rows"`
+			Total []struct {
+				Count int64 `bson:"count"`
+			} `bson:"total"`
+		}{}
+		if err = res.All(ctx, &facets); err != nil {
+			return nil, info, err
+		}
+		// $count yields nothing when no document matches.
+		if len(facets) > 0 {
+			raw = facets[0].Rows
+			if len(facets[0].Total) > 0 {
+				info.TotalCount = facets[0].Total[0].Count
+			}
+		}
+	} else if err = res.All(ctx, &raw); err != nil {
+		return nil, info, err
+	}
+
+	if int64(len(raw)) > limit {
+		raw = raw[:limit]
+		last := raw[len(raw)-1]
+
+		token := pageToken{Field: sortField, Id: last.Lookup("_id")}
+		if sortField != "" {
+			value := last.Lookup(strings.Split(sortField, ".")...)
+			if value.Type == 0 {
+				value = bson.RawValue{Type: bsontype.Null}
+			}
+			token.Value = &value
+		}
+
+		info.NextToken, err = encodePageToken(token)
+		if err != nil {
+			return nil, info, err
+		}
+	}
+
+	result := make([]T, len(raw))
+	for i, doc := range raw {
+		if err = bson.Unmarshal(doc, &result[i]); err != nil {
+			return nil, info, err
+		}
+	}
+
+	return result, info, nil
+}
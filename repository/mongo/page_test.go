@@ -0,0 +1,170 @@
+package mongo
+
+import (
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"reflect"
+	"testing"
+)
+
+func rawValue(t *testing.T, value any) bson.RawValue {
+	t.Helper()
+
+	kind, data, err := bson.MarshalValue(value)
+	if err != nil {
+		t.Fatalf("MarshalValue(%v): %v", value, err)
+	}
+
+	return bson.RawValue{Type: kind, Value: data}
+}
+
+func isNull(value *bson.RawValue) bool {
+	return value == nil || value.Type == bsontype.Null
+}
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	id := rawValue(t, primitive.NewObjectID())
+	name := rawValue(t, "Widget")
+	null := bson.RawValue{Type: bsontype.Null}
+
+	tests := []struct {
+		name  string
+		token pageToken
+	}{
+		{"id only", pageToken{Id: id}},
+		{"sort field", pageToken{Field: "name", Value: &name, Id: id}},
+		{"missing sort value", pageToken{Field: "price.amount", Value: &null, Id: id}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			encoded, err := encodePageToken(test.token)
+			if err != nil {
+				t.Fatalf("encodePageToken: %v", err)
+			}
+
+			decoded, err := decodePageToken(encoded)
+			if err != nil {
+				t.Fatalf("decodePageToken(%q): %v", encoded, err)
+			}
+			if decoded.Field != test.token.Field || !decoded.Id.Equal(test.token.Id) {
+				t.Errorf("decoded = %+v, want %+v", decoded, test.token)
+			}
+			// A null value comes back as no value, which keysetMatch treats the same.
+			if isNull(test.token.Value) {
+				if !isNull(decoded.Value) {
+					t.Errorf("decoded value = %v, want null", decoded.Value)
+				}
+			} else if decoded.Value == nil || !decoded.Value.Equal(*test.token.Value) {
+				t.Errorf("decoded value = %v, want %v", decoded.Value, test.token.Value)
+			}
+		})
+	}
+}
+
+func TestDecodePageTokenInvalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"not base64", "not a token!"},
+		{"not bson", "aGVsbG8"},
+		{"padded base64", "aGVsbG8="},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if _, err := decodePageToken(test.token); !errors.Is(err, repository.ErrInvalidPageToken) {
+				t.Errorf("decodePageToken(%q) error = %v, want %v", test.token, err, repository.ErrInvalidPageToken)
+			}
+		})
+	}
+}
+
+func TestKeysetMatch(t *testing.T) {
+	id := rawValue(t, primitive.NewObjectID())
+	name := rawValue(t, "Widget")
+	null := bson.RawValue{Type: bsontype.Null}
+
+	tests := []struct {
+		name       string
+		token      pageToken
+		descending bool
+		want       bson.M
+	}{
+		{
+			name:  "id only",
+			token: pageToken{Id: id},
+			want:  bson.M{"_id": bson.M{"$gt": id}},
+		},
+		{
+			name:       "id only descending",
+			token:      pageToken{Id: id},
+			descending: true,
+			want:       bson.M{"_id": bson.M{"$lt": id}},
+		},
+		{
+			name:  "sort field",
+			token: pageToken{Field: "name", Value: &name, Id: id},
+			want: bson.M{"$or": bson.A{
+				bson.M{"name": bson.M{"$gt": &name}},
+				bson.M{"name": &name, "_id": bson.M{"$gt": id}},
+			}},
+		},
+		{
+			name:       "sort field descending",
+			token:      pageToken{Field: "name", Value: &name, Id: id},
+			descending: true,
+			want: bson.M{"$or": bson.A{
+				bson.M{"name": bson.M{"$lt": &name}},
+				bson.M{"name": nil},
+				bson.M{"name": &name, "_id": bson.M{"$lt": id}},
+			}},
+		},
+		{
+			name:  "missing value ascending",
+			token: pageToken{Field: "name", Value: &null, Id: id},
+			want: bson.M{"$or": bson.A{
+				bson.M{"name": bson.M{"$ne": nil}},
+				bson.M{"name": &null, "_id": bson.M{"$gt": id}},
+			}},
+		},
+		{
+			name:       "missing value descending",
+			token:      pageToken{Field: "name", Value: &null, Id: id},
+			descending: true,
+			want:       bson.M{"name": nil, "_id": bson.M{"$lt": id}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := keysetMatch(test.token, test.descending); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("keysetMatch() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPageLimit(t *testing.T) {
+	tests := []struct {
+		limit int64
+		want  int64
+	}{
+		{0, models.DefaultPageLimit},
+		{-1, models.DefaultPageLimit},
+		{1, 1},
+		{models.MaxPageLimit, models.MaxPageLimit},
+		{models.MaxPageLimit + 1, models.MaxPageLimit},
+	}
+
+	for _, test := range tests {
+		if got := pageLimit(test.limit); got != test.want {
+			t.Errorf("pageLimit(%d) = %d, want %d", test.limit, got, test.want)
+		}
+	}
+}
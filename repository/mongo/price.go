@@ -0,0 +1,194 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type priceRepository struct {
+	Logger            zerolog.Logger
+	Client            *mongo.Client
+	PriceCollection   *mongo.Collection
+	ProductCollection *mongo.Collection
+	Tx                Tx
+}
+
+func NewPriceRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.PriceRepository {
+	return &priceRepository{
+		Logger:            logger.With().Str("repository", repository.PriceHistoryCollection).Logger(),
+		Client:            database.Client(),
+		PriceCollection:   database.Collection(repository.PriceHistoryCollection),
+		ProductCollection: database.Collection(repository.ProductCollection),
+		Tx:                txImpl,
+	}
+}
+
+// Apply makes a price current: it sets the product price, closes the history entry of the
+// previous price and records the new one. A scheduled change is applied only once, so a change
+// another process has already applied is skipped. An entry is never closed before its own start,
+// even when a late scheduled change takes effect earlier than the entry. A deleted product is
+// reported as not found and keeps its price.
+func (r *priceRepository) Apply(ctx context.Context, change *models.PriceChange) error {
+	productId, err := objectId(change.ProductId)
+	if err != nil {
+		return err
+	}
+
+	scheduled := change.Id != ""
+	var changeId primitive.ObjectID
+	if scheduled {
+		if changeId, err = objectId(change.Id); err != nil {
+			return err
+		}
+	}
+
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		current := bson.M{"product_id": change.ProductId, "status": models.PriceChangeApplied, "effective_to": nil}
+
+		if scheduled {
+			res, err := r.PriceCollection.UpdateOne(ctx,
+				bson.M{"_id": changeId, "status": models.PriceChangeScheduled},
+				bson.M{"$set": bson.M{"status": models.PriceChangeApplied}})
+			if err != nil {
+				return nil, err
+			}
+			if res.MatchedCount == 0 {
+				return change.Id, nil
+			}
+			current["_id"] = bson.M{"$ne": changeId}
+		}
+
+		res, err := r.ProductCollection.UpdateOne(ctx,
+			notDeleted(bson.M{"_id": productId, "price.currency": change.Price.Currency}),
+			bson.M{"$set": bson.M{"price": change.Price}})
+		if err != nil {
+			return nil, err
+		}
+		if res.MatchedCount == 0 {
+			found, err := r.ProductCollection.CountDocuments(ctx, notDeleted(bson.M{"_id": productId}))
+			if err != nil {
+				return nil, err
+			}
+			if found > 0 {
+				return nil, repository.ErrCurrencyChanged
+			}
+			return nil, repository.ErrEntityNotFound
+		}
+
+		closing := bson.A{bson.M{"$set": bson.M{"effective_to": bson.M{"$max": bson.A{"$effective_from", change.EffectiveFrom}}}}}
+		_, err = r.PriceCollection.UpdateMany(ctx, current, closing)
+		if err != nil {
+			return nil, err
+		}
+
+		if scheduled {
+			return change.Id, nil
+		}
+
+		entry := *change
+		entry.Status = models.PriceChangeApplied
+		entry.CreatedAt = time.Now()
+
+		inserted, err := r.PriceCollection.InsertOne(ctx, entry)
+		if err != nil {
+			return nil, err
+		}
+
+		return inserted.InsertedID.(primitive.ObjectID).Hex(), nil
+	}, r.Logger)
+	if err != nil {
+		return err
+	}
+
+	change.Id = res.(string)
+	change.Status = models.PriceChangeApplied
+	return nil
+}
+
+func (r *priceRepository) Schedule(ctx context.Context, change *models.PriceChange) (string, error) {
+	if _, err := objectId(change.ProductId); err != nil {
+		return "", err
+	}
+
+	change.Status = models.PriceChangeScheduled
+	change.CreatedAt = time.Now()
+
+	res, err := r.PriceCollection.InsertOne(ctx, change)
+	if err != nil {
+		return "", err
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *priceRepository) Fail(ctx context.Context, id string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.PriceCollection.UpdateOne(ctx,
+		bson.M{"_id": idObj, "status": models.PriceChangeScheduled},
+		bson.M{"$set": bson.M{"status": models.PriceChangeFailed}})
+
+	return err
+}
+
+// GetDue returns the scheduled changes whose time has come, oldest first.
+func (r *priceRepository) GetDue(ctx context.Context, now time.Time, limit int64) ([]models.PriceChange, error) {
+	changes := []models.PriceChange{}
+
+	filter := bson.M{"status": models.PriceChangeScheduled, "effective_from": bson.M{"$lte": now}}
+	opts := options.Find().SetSort(bson.D{{Key: "effective_from", Value: 1}, {Key: "_id", Value: 1}}).SetLimit(limit)
+
+	res, err := r.PriceCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// GetAt returns the history entry of the price a product had at the given time.
+func (r *priceRepository) GetAt(ctx context.Context, productId string, at time.Time) (models.PriceChange, error) {
+	change := models.PriceChange{}
+
+	if _, err := objectId(productId); err != nil {
+		return change, err
+	}
+
+	filter := bson.M{
+		"product_id":     productId,
+		"status":         models.PriceChangeApplied,
+		"effective_from": bson.M{"$lte": at},
+		"$or": bson.A{
+			bson.M{"effective_to": nil},
+			bson.M{"effective_to": bson.M{"$gt": at}},
+		},
+	}
+	opts := options.FindOne().SetSort(bson.D{{Key: "effective_from", Value: -1}, {Key: "_id", Value: -1}})
+
+	err := r.PriceCollection.FindOne(ctx, filter, opts).Decode(&change)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return change, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return change, err
+	}
+
+	return change, nil
+}
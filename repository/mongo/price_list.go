@@ -0,0 +1,165 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type priceListRepository struct {
+	Logger              zerolog.Logger
+	PriceListCollection *mongo.Collection
+	Tx                  Tx
+}
+
+func NewPriceListRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.PriceListRepository {
+	return &priceListRepository{
+		Logger:              logger.With().Str("repository", repository.PriceListCollection).Logger(),
+		PriceListCollection: database.Collection(repository.PriceListCollection),
+		Tx:                  txImpl,
+	}
+}
+
+func (r *priceListRepository) InsertOne(ctx context.Context, priceList *models.PriceList) (string, error) {
+	res, err := r.PriceListCollection.InsertOne(ctx, priceList)
+	if err != nil {
+		return "", err
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *priceListRepository) Get(ctx context.Context, channel models.SalesChannel, limit, offset int64) ([]models.PriceList, error) {
+	priceLists := []models.PriceList{}
+
+	pipeline := mongo.Pipeline{}
+	if channel != "" {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"channel": channel}}})
+	}
+	pipeline = append(pipeline, getPipeline(limit, offset)...)
+
+	res, err := r.PriceListCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &priceLists)
+	if err != nil {
+		return nil, err
+	}
+
+	return priceLists, nil
+}
+
+func (r *priceListRepository) GetById(ctx context.Context, id string) (models.PriceList, error) {
+	priceList := models.PriceList{}
+
+	idObj, err := objectId(id)
+	if err != nil {
+		return priceList, err
+	}
+
+	err = r.PriceListCollection.FindOne(ctx, bson.M{"_id": idObj}).Decode(&priceList)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return priceList, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return priceList, err
+	}
+
+	return priceList, nil
+}
+
+// GetApplicable returns the lists of a channel that are valid at the given time and price the
+// product, highest priority first.
+func (r *priceListRepository) GetApplicable(ctx context.Context, productId string, channel models.SalesChannel, at time.Time) ([]models.PriceList, error) {
+	priceLists := []models.PriceList{}
+
+	filter := bson.M{
+		"channel":          channel,
+		"items.product_id": productId,
+		"$and": bson.A{
+			bson.M{"$or": bson.A{bson.M{"valid_from": nil}, bson.M{"valid_from": bson.M{"$lte": at}}}},
+			bson.M{"$or": bson.A{bson.M{"valid_to": nil}, bson.M{"valid_to": bson.M{"$gt": at}}}},
+		},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "priority", Value: -1}, {Key: "_id", Value: 1}})
+
+	res, err := r.PriceListCollection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &priceLists)
+	if err != nil {
+		return nil, err
+	}
+
+	return priceLists, nil
+}
+
+func (r *priceListRepository) Update(ctx context.Context, priceList *models.PriceList) error {
+	id, err := objectId(priceList.Id)
+	if err != nil {
+		return err
+	}
+
+	// Validity bounds are replaced as a whole, so an omitted bound is removed.
+	set := bson.M{
+		"name":     priceList.Name,
+		"channel":  priceList.Channel,
+		"priority": priceList.Priority,
+		"items":    priceList.Items,
+	}
+	unset := bson.M{}
+	if priceList.ValidFrom != nil {
+		set["valid_from"] = *priceList.ValidFrom
+	} else {
+		unset["valid_from"] = ""
+	}
+	if priceList.ValidTo != nil {
+		set["valid_to"] = *priceList.ValidTo
+	} else {
+		unset["valid_to"] = ""
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	res, err := r.PriceListCollection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *priceListRepository) Delete(ctx context.Context, id string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.PriceListCollection.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
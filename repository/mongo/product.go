@@ -2,30 +2,42 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"github.com/igntnk/stocky_iims/models"
 	"github.com/igntnk/stocky_iims/repository"
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"regexp"
+	"time"
 )
 
 type productRepository struct {
 	Logger            zerolog.Logger
+	Client            *mongo.Client
 	ProductCollection *mongo.Collection
-	Tx                Tx
+	// The collections below keep data for products and are cleaned up when a product is purged.
+	// The movement ledger is append-only and is only written to.
+	VariantCollection   *mongo.Collection
+	StockCollection     *mongo.Collection
+	MovementCollection  *mongo.Collection
+	PriceCollection     *mongo.Collection
+	PriceListCollection *mongo.Collection
+	Tx                  Tx
 }
 
-func NewProductRepository(ctx context.Context, database *mongo.Database, trxImpl bool, logger zerolog.Logger) repository.ProductRepository {
-	tx := noTxImpl
-	if trxImpl {
-		tx = txImpl
-	}
-
+func NewProductRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.ProductRepository {
 	return &productRepository{
-		Logger:            logger.With().Str("repository", repository.ProductCollection).Logger(),
-		ProductCollection: database.Collection(repository.ProductCollection),
-		Tx:                tx,
+		Logger:              logger.With().Str("repository", repository.ProductCollection).Logger(),
+		Client:              database.Client(),
+		ProductCollection:   database.Collection(repository.ProductCollection),
+		VariantCollection:   database.Collection(repository.VariantCollection),
+		StockCollection:     database.Collection(repository.StockCollection),
+		MovementCollection:  database.Collection(repository.MovementCollection),
+		PriceCollection:     database.Collection(repository.PriceHistoryCollection),
+		PriceListCollection: database.Collection(repository.PriceListCollection),
+		Tx:                  txImpl,
 	}
 }
 
@@ -33,15 +45,15 @@ func getPipeline(limit, offset int64) mongo.Pipeline {
 	pipeline := mongo.Pipeline{}
 	if offset > 0 {
 		pipeline = append(pipeline, bson.D{{
-			"$skip",
-			offset,
+			Key:   "$skip",
+			Value: offset,
 		}})
 	}
 
 	if limit > 0 {
 		pipeline = append(pipeline, bson.D{{
-			"$limit",
-			limit,
+			Key:   "$limit",
+			Value: limit,
 		}})
 	}
 	return pipeline
@@ -49,16 +61,90 @@ func getPipeline(limit, offset int64) mongo.Pipeline {
 
 func (r *productRepository) InsertOne(ctx context.Context, product *models.Product) (string, error) {
 	res, err := r.ProductCollection.InsertOne(ctx, product)
+	if mongo.IsDuplicateKeyError(err) {
+		return "", repository.ErrAlreadyExists
+	}
 	if err != nil {
 		return "", err
 	}
 
-	return res.InsertedID.(string), nil
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
 }
 
-func (r *productRepository) Get(ctx context.Context, limit, offset int64) ([]models.Product, error) {
-	products := []models.Product{}
-	pipeline := getPipeline(limit, offset)
+// productMatch builds the $match condition of a product listing.
+func productMatch(filter models.ProductFilter) bson.M {
+	match := bson.M{}
+	if filter.CategoryIds != nil {
+		match["category_id"] = bson.M{"$in": filter.CategoryIds}
+	}
+	if filter.NameContains != "" {
+		match["name"] = bson.M{"$regex": regexp.QuoteMeta(filter.NameContains), "$options": "i"}
+	}
+	if filter.MinPrice != nil || filter.MaxPrice != nil {
+		amount := bson.M{}
+		if filter.MinPrice != nil {
+			amount["$gte"] = filter.MinPrice.Amount
+			match["price.currency"] = filter.MinPrice.Currency
+		}
+		if filter.MaxPrice != nil {
+			amount["$lte"] = filter.MaxPrice.Amount
+			match["price.currency"] = filter.MaxPrice.Currency
+		}
+		match["price.amount"] = amount
+	}
+	if filter.Blocked != nil {
+		if *filter.Blocked {
+			match["blocked"] = true
+		} else {
+			match["blocked"] = bson.M{"$ne": true}
+		}
+	} else if !filter.IncludeBlocked {
+		match["blocked"] = bson.M{"$ne": true}
+	}
+	if filter.CreatedFrom != nil || filter.CreatedTo != nil {
+		creationDate := bson.M{}
+		if filter.CreatedFrom != nil {
+			creationDate["$gte"] = *filter.CreatedFrom
+		}
+		if filter.CreatedTo != nil {
+			creationDate["$lt"] = *filter.CreatedTo
+		}
+		match["creation_date"] = creationDate
+	}
+	for name, values := range filter.Attributes {
+		match["attributes."+name] = bson.M{"$in": values}
+	}
+	if !filter.IncludeDeleted {
+		notDeleted(match)
+	}
+
+	return match
+}
+
+func (r *productRepository) Get(ctx context.Context, filter models.ProductFilter, page models.PageRequest) ([]models.Product, models.PageInfo, error) {
+	return paginate[models.Product](ctx, r.ProductCollection, productMatch(filter), filter.SortField, filter.SortDescending, page)
+}
+
+func (r *productRepository) Count(ctx context.Context, filter models.ProductFilter) (int64, error) {
+	return r.ProductCollection.CountDocuments(ctx, productMatch(filter))
+}
+
+// Search runs a full-text query against the product search index and orders the matches by relevance.
+func (r *productRepository) Search(ctx context.Context, query string, includeBlocked bool, limit, offset int64) ([]models.ScoredProduct, error) {
+	products := []models.ScoredProduct{}
+
+	match := notDeleted(bson.M{"$text": bson.M{"$search": query}})
+	if !includeBlocked {
+		match["blocked"] = bson.M{"$ne": true}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: match}},
+		{{Key: "$addFields", Value: bson.M{"score": bson.M{"$meta": "textScore"}}}},
+		{{Key: "$sort", Value: bson.D{{Key: "score", Value: -1}, {Key: "_id", Value: 1}}}},
+	}
+	pipeline = append(pipeline, getPipeline(limit, offset)...)
+
 	res, err := r.ProductCollection.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, err
@@ -73,15 +159,18 @@ func (r *productRepository) Get(ctx context.Context, limit, offset int64) ([]mod
 	return products, nil
 }
 
-func (r *productRepository) GetById(ctx context.Context, id string) (models.Product, error) {
+func (r *productRepository) GetById(ctx context.Context, id string, includeDeleted bool) (models.Product, error) {
 	product := models.Product{}
 
-	idObj, err := primitive.ObjectIDFromHex(id)
+	filter, err := byId(id, includeDeleted)
 	if err != nil {
 		return product, err
 	}
 
-	err = r.ProductCollection.FindOne(ctx, bson.M{"_id": idObj}).Decode(&product)
+	err = r.ProductCollection.FindOne(ctx, filter).Decode(&product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return product, repository.ErrEntityNotFound
+	}
 	if err != nil {
 		return product, err
 	}
@@ -89,10 +178,18 @@ func (r *productRepository) GetById(ctx context.Context, id string) (models.Prod
 	return product, nil
 }
 
-func (r *productRepository) GetByProductCode(ctx context.Context, code string) (models.Product, error) {
+func (r *productRepository) GetByProductCode(ctx context.Context, code string, includeDeleted bool) (models.Product, error) {
 	product := models.Product{}
 
-	err := r.ProductCollection.FindOne(ctx, bson.M{"product_code": code}).Decode(&product)
+	filter := bson.M{"product_code": code}
+	if !includeDeleted {
+		notDeleted(filter)
+	}
+
+	err := r.ProductCollection.FindOne(ctx, filter).Decode(&product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return product, repository.ErrEntityNotFound
+	}
 	if err != nil {
 		return product, err
 	}
@@ -100,57 +197,141 @@ func (r *productRepository) GetByProductCode(ctx context.Context, code string) (
 	return product, nil
 }
 
-func (r *productRepository) Delete(ctx context.Context, id string) error {
-	idObj, err := primitive.ObjectIDFromHex(id)
-	if err != nil {
-		return err
+func (r *productRepository) GetByBarcode(ctx context.Context, barcode string) (models.Product, error) {
+	product := models.Product{}
+
+	err := r.ProductCollection.FindOne(ctx, notDeleted(bson.M{"barcodes": barcode})).Decode(&product)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return product, repository.ErrEntityNotFound
 	}
-	_, err = r.ProductCollection.DeleteOne(ctx, bson.M{"_id": idObj})
 	if err != nil {
-		return err
+		return product, err
 	}
 
-	return nil
+	return product, nil
 }
 
-func (r *productRepository) Update(ctx context.Context, product *models.Product) error {
-	id, err := primitive.ObjectIDFromHex(product.Id)
+func (r *productRepository) Delete(ctx context.Context, id string) error {
+	return softDelete(ctx, r.ProductCollection, id)
+}
+
+func (r *productRepository) Restore(ctx context.Context, id string) error {
+	return restore(ctx, r.ProductCollection, id)
+}
+
+// Purge removes a deleted product for good together with its variants, stock balances, price
+// history and scheduled price changes, and its price list entries. Its movements stay in the
+// ledger: whatever was still on hand is written off first, so that rebuilding the balances from
+// the ledger does not bring them back.
+func (r *productRepository) Purge(ctx context.Context, id string) error {
+	_, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		if err := purge(ctx, r.ProductCollection, id); err != nil {
+			return nil, err
+		}
+
+		byProduct := bson.M{"product_id": id}
+		if err := r.writeOffStock(ctx, id); err != nil {
+			return nil, err
+		}
+		for _, collection := range []*mongo.Collection{r.VariantCollection, r.StockCollection, r.PriceCollection} {
+			if _, err := collection.DeleteMany(ctx, byProduct); err != nil {
+				return nil, err
+			}
+		}
+
+		_, err := r.PriceListCollection.UpdateMany(ctx, bson.M{"items.product_id": id}, bson.M{"$pull": bson.M{"items": byProduct}})
+		return nil, err
+	}, r.Logger)
+
+	return err
+}
+
+// purgeActor is recorded on the write-offs booked when a product is purged.
+const purgeActor = "purge"
+
+// writeOffStock books a write-off for every balance of a product that still holds stock on hand.
+func (r *productRepository) writeOffStock(ctx context.Context, id string) error {
+	items := []models.StockItem{}
+	cursor, err := r.StockCollection.Find(ctx, bson.M{"product_id": id, "quantity": bson.M{"$ne": 0}})
 	if err != nil {
 		return err
 	}
-
-	_, err = r.ProductCollection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": product})
-	if err != nil {
+	if err = cursor.All(ctx, &items); err != nil {
 		return err
 	}
 
+	for _, item := range items {
+		err = insertMovement(ctx, r.MovementCollection, &models.StockMovement{
+			StockKey: item.StockKey,
+			Reason:   models.MovementReasonWriteOff,
+			Delta:    -item.Quantity,
+			Actor:    purgeActor,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
-func (r *productRepository) BlockProduct(ctx context.Context, id string) error {
-	idObj, err := primitive.ObjectIDFromHex(id)
+func (r *productRepository) GetDeletedBefore(ctx context.Context, t time.Time) ([]models.Product, error) {
+	products := []models.Product{}
+
+	res, err := r.ProductCollection.Find(ctx, bson.M{"deleted_at": bson.M{"$lt": t}})
 	if err != nil {
-		return err
+		return nil, err
 	}
+	defer res.Close(ctx)
 
-	_, err = r.ProductCollection.UpdateOne(ctx, bson.M{"_id": idObj}, bson.M{"$set": bson.M{"blocked": true}})
+	err = res.All(ctx, &products)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return products, nil
 }
 
-func (r *productRepository) UnblockProduct(ctx context.Context, id string) error {
-	idObj, err := primitive.ObjectIDFromHex(id)
+// Update leaves the price alone: prices change through the price repository, which keeps the
+// price history.
+func (r *productRepository) Update(ctx context.Context, product *models.Product) error {
+	id, err := objectId(product.Id)
 	if err != nil {
 		return err
 	}
 
-	_, err = r.ProductCollection.UpdateOne(ctx, bson.M{"_id": idObj}, bson.M{"$set": bson.M{"blocked": false}})
+	set := bson.M{
+		"name":        product.Name,
+		"description": product.Description,
+	}
+	if product.ProductCode != "" {
+		set["product_code"] = product.ProductCode
+	}
+	if len(product.Barcodes) > 0 {
+		set["barcodes"] = product.Barcodes
+	}
+	if product.CategoryId != "" {
+		set["category_id"] = product.CategoryId
+	}
+	if product.Attributes != nil {
+		set["attributes"] = product.Attributes
+	}
+
+	_, err = r.ProductCollection.UpdateOne(ctx, notDeleted(bson.M{"_id": id}), bson.M{"$set": set})
+	if mongo.IsDuplicateKeyError(err) {
+		return repository.ErrAlreadyExists
+	}
 	if err != nil {
 		return err
 	}
 
 	return nil
 }
+
+func (r *productRepository) BlockProduct(ctx context.Context, id, actor, reason string) error {
+	return setBlocked(ctx, r.ProductCollection, id, blockUpdate(actor, reason))
+}
+
+func (r *productRepository) UnblockProduct(ctx context.Context, id string) error {
+	return setBlocked(ctx, r.ProductCollection, id, unblockUpdate())
+}
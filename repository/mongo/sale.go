@@ -2,110 +2,570 @@ package mongo
 
 import (
 	"context"
+	"errors"
 	"github.com/igntnk/stocky_iims/models"
 	"github.com/igntnk/stocky_iims/repository"
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/bson/primitive"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
 )
 
 type saleRepository struct {
-	Logger         zerolog.Logger
-	SaleCollection *mongo.Collection
-	Tx             Tx
+	Logger               zerolog.Logger
+	Client               *mongo.Client
+	SaleCollection       *mongo.Collection
+	CouponCollection     *mongo.Collection
+	RedemptionCollection *mongo.Collection
+	Tx                   Tx
 }
 
-func NewSaleRepository(ctx context.Context, database *mongo.Database, trxImpl bool, logger zerolog.Logger) repository.SaleRepository {
-	tx := noTxImpl
-	if trxImpl {
-		tx = txImpl
+// saleDocument is a sale as stored, with product references as ObjectIds.
+type saleDocument struct {
+	models.Sale        `bson:",inline"`
+	ProductIds         []primitive.ObjectID `bson:"product_ids,omitempty"`
+	ExcludedProductIds []primitive.ObjectID `bson:"excluded_product_ids,omitempty"`
+	BundleProductIds   []primitive.ObjectID `bson:"bundle_product_ids,omitempty"`
+	VariantIds         []primitive.ObjectID `bson:"variant_ids,omitempty"`
+}
+
+func newSaleDocument(sale models.Sale) (saleDocument, error) {
+	document := saleDocument{Sale: sale}
+
+	var err error
+	if document.ProductIds, err = objectIds(sale.ProductIds); err != nil {
+		return document, err
+	}
+	if document.ExcludedProductIds, err = objectIds(sale.ExcludedProductIds); err != nil {
+		return document, err
+	}
+	if document.BundleProductIds, err = objectIds(sale.BundleProductIds); err != nil {
+		return document, err
+	}
+	if document.VariantIds, err = objectIds(sale.VariantIds); err != nil {
+		return document, err
 	}
 
+	return document, nil
+}
+
+func (d saleDocument) model() models.Sale {
+	sale := d.Sale
+	sale.ProductIds = hexIds(d.ProductIds)
+	sale.ExcludedProductIds = hexIds(d.ExcludedProductIds)
+	sale.BundleProductIds = hexIds(d.BundleProductIds)
+	sale.VariantIds = hexIds(d.VariantIds)
+
+	return sale
+}
+
+func saleModels(documents []saleDocument) []models.Sale {
+	sales := make([]models.Sale, len(documents))
+	for i, document := range documents {
+		sales[i] = document.model()
+	}
+
+	return sales
+}
+
+func (r *saleRepository) find(ctx context.Context, filter bson.M, opts ...*options.FindOptions) ([]models.Sale, error) {
+	documents := []saleDocument{}
+
+	res, err := r.SaleCollection.Find(ctx, filter, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &documents)
+	if err != nil {
+		return nil, err
+	}
+
+	return saleModels(documents), nil
+}
+
+func NewSaleRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.SaleRepository {
 	return &saleRepository{
-		Logger:         logger.With().Str("repository", repository.SaleCollection).Logger(),
-		SaleCollection: database.Collection(repository.SaleCollection),
-		Tx:             tx,
+		Logger:               logger.With().Str("repository", repository.SaleCollection).Logger(),
+		Client:               database.Client(),
+		SaleCollection:       database.Collection(repository.SaleCollection),
+		CouponCollection:     database.Collection(repository.CouponCollection),
+		RedemptionCollection: database.Collection(repository.CouponRedemptionCollection),
+		Tx:                   txImpl,
 	}
 }
 
 func (r *saleRepository) InsertOne(ctx context.Context, sale *models.Sale) (string, error) {
-	res, err := r.SaleCollection.InsertOne(ctx, sale)
+	document, err := newSaleDocument(*sale)
+	if err != nil {
+		return "", err
+	}
+
+	res, err := r.SaleCollection.InsertOne(ctx, document)
 	if err != nil {
 		return "", err
 	}
 
-	return res.InsertedID.(string), nil
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
 }
 
-func (r *saleRepository) Get(ctx context.Context, limit, offset int64) ([]models.Sale, error) {
-	sales := []models.Sale{}
-	pipeline := getPipeline(limit, offset)
-	res, err := r.SaleCollection.Aggregate(ctx, pipeline)
+// startedBy matches sales whose window has opened by the time t.
+func startedBy(t time.Time) bson.M {
+	return bson.M{"$or": bson.A{bson.M{"starts_at": nil}, bson.M{"starts_at": bson.M{"$lte": t}}}}
+}
+
+// notEndedBy matches sales whose window is still open at the time t.
+func notEndedBy(t time.Time) bson.M {
+	return bson.M{"$or": bson.A{bson.M{"ends_at": nil}, bson.M{"ends_at": bson.M{"$gt": t}}}}
+}
+
+// targeting matches sales that may apply to the products or categories. Each branch is served
+// by its own index; exclusions are left to the caller, or to appliesTo for a single product.
+func targeting(productIds []primitive.ObjectID, categoryIds []string) bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"product_ids": bson.M{"$in": productIds}},
+		bson.M{"category_ids": bson.M{"$in": categoryIds}},
+		bson.M{"target": models.SaleTargetAll},
+	}}
+}
+
+func appliesTo(productId primitive.ObjectID, categoryIds []string) bson.A {
+	return bson.A{
+		targeting([]primitive.ObjectID{productId}, categoryIds),
+		bson.M{"excluded_product_ids": bson.M{"$ne": productId}},
+	}
+}
+
+// referencing matches the sales that name the product as a target or as part of a bundle.
+func referencing(productId primitive.ObjectID) bson.M {
+	return bson.M{"$or": bson.A{
+		bson.M{"product_ids": productId},
+		bson.M{"bundle_product_ids": productId},
+	}}
+}
+
+func (r *saleRepository) Get(ctx context.Context, filter models.SaleFilter, page models.PageRequest) ([]models.Sale, models.PageInfo, error) {
+	and := bson.A{}
+	if filter.ActiveAt != nil {
+		and = append(and, startedBy(*filter.ActiveAt), notEndedBy(*filter.ActiveAt))
+	}
+	if filter.ProductId != "" {
+		productId, err := objectId(filter.ProductId)
+		if err != nil {
+			return nil, models.PageInfo{}, err
+		}
+		and = append(and, appliesTo(productId, filter.CategoryIds)...)
+	}
+
+	match := bson.M{}
+	if len(and) > 0 {
+		match["$and"] = and
+	}
+	visible(match, filter)
+
+	documents, info, err := paginate[saleDocument](ctx, r.SaleCollection, match, "", false, page)
 	if err != nil {
-		return nil, err
+		return nil, info, err
 	}
-	defer res.Close(ctx)
 
-	err = res.All(ctx, &sales)
+	return saleModels(documents), info, nil
+}
+
+// visible leaves the blocked and deleted sales out of match unless the filter asks for them.
+func visible(match bson.M, filter models.SaleFilter) {
+	if !filter.IncludeBlocked {
+		match["blocked"] = bson.M{"$ne": true}
+	}
+	if !filter.IncludeDeleted {
+		notDeleted(match)
+	}
+}
+
+func (r *saleRepository) GetForProduct(ctx context.Context, filter models.SaleFilter, page models.PageRequest) ([]models.Sale, models.PageInfo, error) {
+	id, err := objectId(filter.ProductId)
 	if err != nil {
-		return nil, err
+		return nil, models.PageInfo{}, err
+	}
+
+	match := referencing(id)
+	if filter.ActiveAt != nil {
+		match["$and"] = bson.A{startedBy(*filter.ActiveAt), notEndedBy(*filter.ActiveAt)}
+	}
+	visible(match, filter)
+
+	documents, info, err := paginate[saleDocument](ctx, r.SaleCollection, match, "", false, page)
+	if err != nil {
+		return nil, info, err
 	}
 
-	return sales, nil
+	return saleModels(documents), info, nil
 }
 
-func (r *saleRepository) Delete(ctx context.Context, id string) error {
-	idObj, err := primitive.ObjectIDFromHex(id)
+// BlockForProduct blocks the sales that refer to the product and remembers the product on them,
+// so that UnblockForProduct can tell them from sales blocked for other reasons.
+func (r *saleRepository) BlockForProduct(ctx context.Context, productId, reason string) (int64, error) {
+	id, err := objectId(productId)
 	if err != nil {
-		return err
+		return 0, err
 	}
-	_, err = r.SaleCollection.DeleteOne(ctx, bson.M{"_id": idObj})
+
+	filter := notDeleted(referencing(id))
+	filter["blocked"] = bson.M{"$ne": true}
+
+	update := blockUpdate("", reason)
+	update["$set"].(bson.M)["blocked_for_product_id"] = id
+
+	res, err := r.SaleCollection.UpdateMany(ctx, filter, update)
 	if err != nil {
-		return err
+		return 0, err
 	}
 
-	return nil
+	return res.ModifiedCount, nil
 }
 
-func (r *saleRepository) Update(ctx context.Context, Sale *models.Sale) error {
-	id, err := primitive.ObjectIDFromHex(Sale.Id)
+func (r *saleRepository) UnblockForProduct(ctx context.Context, productId string) (int64, error) {
+	id, err := objectId(productId)
 	if err != nil {
-		return err
+		return 0, err
+	}
+
+	res, err := r.SaleCollection.UpdateMany(ctx, bson.M{"blocked_for_product_id": id}, withoutProductBlock(unblockUpdate()))
+	if err != nil {
+		return 0, err
+	}
+
+	return res.ModifiedCount, nil
+}
+
+// DeleteForProduct deletes the bundles with the product and the sales that target only the
+// product, and detaches the product from the targets of the other sales. Both are marked with
+// the product so that RestoreForProduct can undo them.
+func (r *saleRepository) DeleteForProduct(ctx context.Context, productId string) (int64, error) {
+	id, err := objectId(productId)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		deleted, err := r.SaleCollection.UpdateMany(ctx,
+			notDeleted(bson.M{"$or": bson.A{
+				bson.M{"product_ids": bson.A{id}},
+				bson.M{"bundle_product_ids": id},
+			}}),
+			bson.M{"$set": bson.M{"deleted_at": time.Now(), "deleted_for_product_id": id}})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.SaleCollection.UpdateMany(ctx, notDeleted(bson.M{"product_ids": id}), bson.M{
+			"$pull":     bson.M{"product_ids": id},
+			"$addToSet": bson.M{"detached_product_ids": id},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return deleted.ModifiedCount, nil
+	}, r.Logger)
+	if err != nil {
+		return 0, err
 	}
 
-	_, err = r.SaleCollection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": Sale})
+	return res.(int64), nil
+}
+
+func (r *saleRepository) RestoreForProduct(ctx context.Context, productId string) (int64, error) {
+	id, err := objectId(productId)
+	if err != nil {
+		return 0, err
+	}
+
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		restored, err := r.SaleCollection.UpdateMany(ctx, bson.M{"deleted_for_product_id": id},
+			bson.M{"$unset": bson.M{"deleted_at": "", "deleted_for_product_id": ""}})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.SaleCollection.UpdateMany(ctx, bson.M{"detached_product_ids": id}, bson.M{
+			"$addToSet": bson.M{"product_ids": id},
+			"$pull":     bson.M{"detached_product_ids": id},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return restored.ModifiedCount, nil
+	}, r.Logger)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.(int64), nil
+}
+
+func (r *saleRepository) ForgetProduct(ctx context.Context, productId string) error {
+	id, err := objectId(productId)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	_, err = r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		// A bundle without one of its products, or a product sale without products, is no longer
+		// the sale that was set up, so it is deleted rather than left to apply to the rest.
+		_, err := r.SaleCollection.UpdateMany(ctx,
+			notDeleted(bson.M{"$or": bson.A{
+				bson.M{"product_ids": bson.A{id}},
+				bson.M{"bundle_product_ids": id},
+			}}),
+			bson.M{"$set": bson.M{"deleted_at": time.Now()}})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.SaleCollection.UpdateMany(ctx,
+			bson.M{"$or": bson.A{
+				bson.M{"product_ids": id},
+				bson.M{"bundle_product_ids": id},
+				bson.M{"detached_product_ids": id},
+				bson.M{"excluded_product_ids": id},
+			}},
+			bson.M{"$pull": bson.M{
+				"product_ids":          id,
+				"bundle_product_ids":   id,
+				"detached_product_ids": id,
+				"excluded_product_ids": id,
+			}})
+		if err != nil {
+			return nil, err
+		}
+
+		// The product can no longer be restored, so the sales it blocked or deleted stay as they
+		// are until they are unblocked, restored or purged by hand.
+		for _, marker := range []string{"blocked_for_product_id", "deleted_for_product_id"} {
+			_, err = r.SaleCollection.UpdateMany(ctx, bson.M{marker: id}, bson.M{"$unset": bson.M{marker: ""}})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return nil, nil
+	}, r.Logger)
+	return err
+}
+
+func (r *saleRepository) Delete(ctx context.Context, id string) error {
+	return softDelete(ctx, r.SaleCollection, id)
 }
 
-func (r *saleRepository) BlockSale(ctx context.Context, id string) error {
-	idObj, err := primitive.ObjectIDFromHex(id)
+func (r *saleRepository) Restore(ctx context.Context, id string) error {
+	return restore(ctx, r.SaleCollection, id)
+}
+
+// Purge removes a deleted sale for good together with its coupons.
+func (r *saleRepository) Purge(ctx context.Context, id string) error {
+	_, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		if err := purge(ctx, r.SaleCollection, id); err != nil {
+			return nil, err
+		}
+
+		return nil, deleteSaleCoupons(ctx, r.CouponCollection, r.RedemptionCollection, []string{id})
+	}, r.Logger)
+
+	return err
+}
+
+// PurgeDeletedBefore removes the sales deleted before the time together with their coupons.
+func (r *saleRepository) PurgeDeletedBefore(ctx context.Context, t time.Time) (int64, error) {
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		filter := bson.M{"deleted_at": bson.M{"$lt": t}}
+
+		documents := []struct {
+			Id primitive.ObjectID `bson:"_id"`
+		}{}
+		found, err := r.SaleCollection.Find(ctx, filter, options.Find().SetProjection(bson.M{"_id": 1}))
+		if err != nil {
+			return nil, err
+		}
+		if err = found.All(ctx, &documents); err != nil {
+			return nil, err
+		}
+		if len(documents) == 0 {
+			return int64(0), nil
+		}
+
+		ids := make([]primitive.ObjectID, len(documents))
+		for i, document := range documents {
+			ids[i] = document.Id
+		}
+
+		deleted, err := r.SaleCollection.DeleteMany(ctx, bson.M{"_id": bson.M{"$in": ids}})
+		if err != nil {
+			return nil, err
+		}
+		if err = deleteSaleCoupons(ctx, r.CouponCollection, r.RedemptionCollection, hexIds(ids)); err != nil {
+			return nil, err
+		}
+
+		return deleted.DeletedCount, nil
+	}, r.Logger)
+	if err != nil {
+		return 0, err
+	}
+
+	return res.(int64), nil
+}
+
+func (r *saleRepository) GetById(ctx context.Context, id string, includeDeleted bool) (models.Sale, error) {
+	document := saleDocument{}
+
+	filter, err := byId(id, includeDeleted)
+	if err != nil {
+		return models.Sale{}, err
+	}
+
+	err = r.SaleCollection.FindOne(ctx, filter).Decode(&document)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return models.Sale{}, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return models.Sale{}, err
+	}
+
+	return document.model(), nil
+}
+
+func (r *saleRepository) Update(ctx context.Context, Sale *models.Sale) error {
+	id, err := objectId(Sale.Id)
 	if err != nil {
 		return err
 	}
 
-	_, err = r.SaleCollection.UpdateOne(ctx, bson.M{"_id": idObj}, bson.M{"$set": bson.M{"blocked": true}})
+	set := bson.M{
+		"name":          Sale.Name,
+		"description":   Sale.Description,
+		"sale_size":     Sale.SaleSize,
+		"status":        Sale.Status,
+		"discount_type": Sale.DiscountType,
+	}
+	unset := bson.M{}
+	if Sale.Amount != nil {
+		set["amount"] = *Sale.Amount
+	} else {
+		unset["amount"] = ""
+	}
+	if Sale.BuyQuantity != 0 {
+		set["buy_quantity"] = Sale.BuyQuantity
+		set["free_quantity"] = Sale.FreeQuantity
+	} else {
+		unset["buy_quantity"] = ""
+		unset["free_quantity"] = ""
+	}
+	if len(Sale.BundleProductIds) > 0 {
+		if set["bundle_product_ids"], err = objectIds(Sale.BundleProductIds); err != nil {
+			return err
+		}
+	} else {
+		unset["bundle_product_ids"] = ""
+	}
+	if Sale.StartsAt != nil {
+		set["starts_at"] = *Sale.StartsAt
+	} else {
+		unset["starts_at"] = ""
+	}
+	if Sale.EndsAt != nil {
+		set["ends_at"] = *Sale.EndsAt
+	} else {
+		unset["ends_at"] = ""
+	}
+
+	update := bson.M{"$set": set}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	res, err := r.SaleCollection.UpdateOne(ctx, notDeleted(bson.M{"_id": id}), update)
 	if err != nil {
 		return err
 	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
 
 	return nil
 }
 
+// withoutProductBlock makes a block update forget the product a sale was blocked for, so that
+// restoring the product leaves a sale blocked or unblocked by hand as it is.
+func withoutProductBlock(update bson.M) bson.M {
+	unset, ok := update["$unset"].(bson.M)
+	if !ok {
+		unset = bson.M{}
+		update["$unset"] = unset
+	}
+	unset["blocked_for_product_id"] = ""
+
+	return update
+}
+
+func (r *saleRepository) BlockSale(ctx context.Context, id, actor, reason string) error {
+	return setBlocked(ctx, r.SaleCollection, id, withoutProductBlock(blockUpdate(actor, reason)))
+}
+
 func (r *saleRepository) UnblockSale(ctx context.Context, id string) error {
-	idObj, err := primitive.ObjectIDFromHex(id)
+	return setBlocked(ctx, r.SaleCollection, id, withoutProductBlock(unblockUpdate()))
+}
+
+// GetStatusDue returns the sales whose stored status no longer matches their window at the
+// time now. Deleted sales are left as they are.
+func (r *saleRepository) GetStatusDue(ctx context.Context, now time.Time) ([]models.Sale, error) {
+	filter := bson.M{"deleted_at": nil, "$or": bson.A{
+		bson.M{"status": bson.M{"$ne": models.SaleStatusActive}, "$and": bson.A{startedBy(now), notEndedBy(now)}},
+		bson.M{"status": bson.M{"$ne": models.SaleStatusExpired}, "ends_at": bson.M{"$lte": now}},
+		bson.M{"status": bson.M{"$ne": models.SaleStatusScheduled}, "starts_at": bson.M{"$gt": now}},
+	}}
+
+	return r.find(ctx, filter)
+}
+
+// SetStatus moves a sale from one status to another. It reports false when the sale is no
+// longer in the expected status, for example because another worker already moved it.
+func (r *saleRepository) SetStatus(ctx context.Context, id string, from, to models.SaleStatus) (bool, error) {
+	idObj, err := objectId(id)
 	if err != nil {
-		return err
+		return false, err
+	}
+
+	filter := bson.M{"_id": idObj, "status": from}
+	if from == "" {
+		filter["status"] = bson.M{"$exists": false}
 	}
 
-	_, err = r.SaleCollection.UpdateOne(ctx, bson.M{"_id": idObj}, bson.M{"$set": bson.M{"blocked": false}})
+	res, err := r.SaleCollection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"status": to}})
 	if err != nil {
-		return err
+		return false, err
 	}
 
-	return nil
+	return res.ModifiedCount > 0, nil
+}
+
+// GetActiveForProducts returns the sales of the products that are live at the time t and
+// neither blocked nor deleted, the earliest started first.
+func (r *saleRepository) GetActiveForProducts(ctx context.Context, productIds, categoryIds []string, t time.Time) ([]models.Sale, error) {
+	ids, err := objectIds(productIds)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{
+		"blocked":    bson.M{"$ne": true},
+		"deleted_at": nil,
+		"$and":       bson.A{targeting(ids, categoryIds), startedBy(t), notEndedBy(t)},
+	}
+	opts := options.Find().SetSort(bson.D{{Key: "starts_at", Value: 1}, {Key: "_id", Value: 1}})
+
+	return r.find(ctx, filter, opts)
 }
@@ -0,0 +1,87 @@
+package mongo
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/repository"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"time"
+)
+
+// notDeleted adds the condition that leaves out soft-deleted documents to filter. Documents
+// that were never deleted have no deleted_at field, which the null match covers.
+func notDeleted(filter bson.M) bson.M {
+	filter["deleted_at"] = nil
+	return filter
+}
+
+// byId matches the document with the id, leaving out a soft-deleted one unless includeDeleted is set.
+func byId(id string, includeDeleted bool) (bson.M, error) {
+	idObj, err := objectId(id)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"_id": idObj}
+	if !includeDeleted {
+		notDeleted(filter)
+	}
+
+	return filter, nil
+}
+
+// softDelete marks a document as deleted. Deleting it again is reported as not found.
+func softDelete(ctx context.Context, collection *mongo.Collection, id string) error {
+	filter, err := byId(id, false)
+	if err != nil {
+		return err
+	}
+
+	res, err := collection.UpdateOne(ctx, filter, bson.M{"$set": bson.M{"deleted_at": time.Now()}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// restore brings a soft-deleted document back. Restoring a document that is not deleted does nothing.
+func restore(ctx context.Context, collection *mongo.Collection, id string) error {
+	filter, err := byId(id, true)
+	if err != nil {
+		return err
+	}
+
+	res, err := collection.UpdateOne(ctx, filter, bson.M{"$unset": bson.M{"deleted_at": ""}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+// purge removes a soft-deleted document for good. A document that is not deleted is not touched
+// and is reported as not found.
+func purge(ctx context.Context, collection *mongo.Collection, id string) error {
+	filter, err := byId(id, true)
+	if err != nil {
+		return err
+	}
+	filter["deleted_at"] = bson.M{"$ne": nil}
+
+	res, err := collection.DeleteOne(ctx, filter)
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,367 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"maps"
+	"slices"
+	"time"
+)
+
+type stockRepository struct {
+	Logger             zerolog.Logger
+	Client             *mongo.Client
+	StockCollection    *mongo.Collection
+	MovementCollection *mongo.Collection
+	Tx                 Tx
+}
+
+func NewStockRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.StockRepository {
+	return &stockRepository{
+		Logger:             logger.With().Str("repository", repository.StockCollection).Logger(),
+		Client:             database.Client(),
+		StockCollection:    database.Collection(repository.StockCollection),
+		MovementCollection: database.Collection(repository.MovementCollection),
+		Tx:                 txImpl,
+	}
+}
+
+// availableAtLeast matches stock documents whose quantity minus reserved is not less than qty.
+func availableAtLeast(qty int64) bson.M {
+	return bson.M{"$gte": bson.A{bson.M{"$subtract": bson.A{"$quantity", "$reserved"}}, qty}}
+}
+
+// keyFilter matches the stock document of one product, or one of its variants, in one warehouse.
+func keyFilter(key models.StockKey) (bson.M, error) {
+	if _, err := objectId(key.ProductId); err != nil {
+		return nil, err
+	}
+	if _, err := objectId(key.WarehouseId); err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{"product_id": key.ProductId, "warehouse_id": key.WarehouseId, "variant_id": nil}
+	if key.VariantId != "" {
+		if _, err := objectId(key.VariantId); err != nil {
+			return nil, err
+		}
+		filter["variant_id"] = key.VariantId
+	}
+
+	return filter, nil
+}
+
+func (r *stockRepository) Get(ctx context.Context, key models.StockKey) (models.StockItem, error) {
+	item := models.StockItem{}
+
+	filter, err := keyFilter(key)
+	if err != nil {
+		return item, err
+	}
+
+	err = r.StockCollection.FindOne(ctx, filter).Decode(&item)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return models.StockItem{StockKey: key}, nil
+	}
+	if err != nil {
+		return item, err
+	}
+
+	return item, nil
+}
+
+func (r *stockRepository) GetByProduct(ctx context.Context, productId string) ([]models.StockItem, error) {
+	items := []models.StockItem{}
+
+	if _, err := objectId(productId); err != nil {
+		return nil, err
+	}
+
+	res, err := r.StockCollection.Find(ctx, bson.M{"product_id": productId})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+// adjustUpdate changes the on-hand quantity by delta. A balance created by it starts with nothing
+// reserved or in transit, so that it reads like every other balance.
+func adjustUpdate(delta int64) bson.M {
+	return bson.M{
+		"$inc":         bson.M{"quantity": delta},
+		"$set":         bson.M{"updated_at": time.Now()},
+		"$setOnInsert": bson.M{"reserved": int64(0), "in_transit": int64(0)},
+	}
+}
+
+// Adjust atomically changes the on-hand quantity by the movement delta and records the movement
+// in the ledger. A negative delta is applied only while the available quantity covers it, so stock
+// never drops below what is reserved.
+func (r *stockRepository) Adjust(ctx context.Context, movement *models.StockMovement) (models.StockItem, error) {
+	filter, err := keyFilter(movement.StockKey)
+	if err != nil {
+		return models.StockItem{}, err
+	}
+
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+	if movement.Delta < 0 {
+		filter["$expr"] = availableAtLeast(-movement.Delta)
+	} else {
+		opts.SetUpsert(true)
+	}
+
+	update := adjustUpdate(movement.Delta)
+
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		item := models.StockItem{}
+
+		err := r.StockCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&item)
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, repository.ErrInsufficientStock
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		err = insertMovement(ctx, r.MovementCollection, movement)
+		if err != nil {
+			return nil, err
+		}
+
+		return item, nil
+	}, r.Logger)
+	if err != nil {
+		return models.StockItem{}, err
+	}
+
+	return res.(models.StockItem), nil
+}
+
+func (r *stockRepository) Reserve(ctx context.Context, key models.StockKey, qty int64) (models.StockItem, error) {
+	item := models.StockItem{}
+
+	filter, err := keyFilter(key)
+	if err != nil {
+		return item, err
+	}
+	filter["$expr"] = availableAtLeast(qty)
+
+	update := bson.M{
+		"$inc": bson.M{"reserved": qty},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err = r.StockCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&item)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return item, repository.ErrInsufficientStock
+	}
+	if err != nil {
+		return item, err
+	}
+
+	return item, nil
+}
+
+func (r *stockRepository) Release(ctx context.Context, key models.StockKey, qty int64) (models.StockItem, error) {
+	item := models.StockItem{}
+
+	filter, err := keyFilter(key)
+	if err != nil {
+		return item, err
+	}
+	filter["reserved"] = bson.M{"$gte": qty}
+
+	update := bson.M{
+		"$inc": bson.M{"reserved": -qty},
+		"$set": bson.M{"updated_at": time.Now()},
+	}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err = r.StockCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&item)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return item, repository.ErrInsufficientReserved
+	}
+	if err != nil {
+		return item, err
+	}
+
+	return item, nil
+}
+
+// RebuildBalances recomputes on-hand quantities from the movement ledger. Every stock document whose
+// quantity differs from the ledger total is reported, and corrected unless dryRun is set. Without a
+// product every product with stock or movements is rebuilt, each in its own transaction, so that
+// a large ledger does not have to fit in one.
+func (r *stockRepository) RebuildBalances(ctx context.Context, productId string, dryRun bool) ([]models.BalanceDrift, error) {
+	if productId != "" {
+		if _, err := objectId(productId); err != nil {
+			return nil, err
+		}
+		return r.rebuildProduct(ctx, productId, dryRun)
+	}
+
+	productIds := map[string]bool{}
+	for _, collection := range []*mongo.Collection{r.StockCollection, r.MovementCollection} {
+		ids, err := collection.Distinct(ctx, "product_id", bson.M{})
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			if id, ok := id.(string); ok {
+				productIds[id] = true
+			}
+		}
+	}
+
+	drifts := []models.BalanceDrift{}
+	for _, id := range slices.Sorted(maps.Keys(productIds)) {
+		rebuilt, err := r.rebuildProduct(ctx, id, dryRun)
+		if err != nil {
+			return nil, err
+		}
+		drifts = append(drifts, rebuilt...)
+	}
+
+	return drifts, nil
+}
+
+// rebuildProduct rebuilds the balances of one product in one transaction.
+func (r *stockRepository) rebuildProduct(ctx context.Context, productId string, dryRun bool) ([]models.BalanceDrift, error) {
+	match := bson.M{"product_id": productId}
+
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		rebuilt := map[models.StockKey]int64{}
+
+		totals, err := r.MovementCollection.Aggregate(ctx, mongo.Pipeline{
+			{{Key: "$match", Value: match}},
+			{{Key: "$group", Value: bson.M{
+				"_id":   bson.M{"product_id": "$product_id", "warehouse_id": "$warehouse_id", "variant_id": "$variant_id"},
+				"total": bson.M{"$sum": "$delta"},
+			}}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer totals.Close(ctx)
+
+		for totals.Next(ctx) {
+			total := struct {
+				Key   models.StockKey `bson:"_id"`
+				Total int64           `bson:"total"`
+			}{}
+			if err = totals.Decode(&total); err != nil {
+				return nil, err
+			}
+			rebuilt[total.Key] = total.Total
+		}
+		if err = totals.Err(); err != nil {
+			return nil, err
+		}
+
+		items := []models.StockItem{}
+		cursor, err := r.StockCollection.Find(ctx, match)
+		if err != nil {
+			return nil, err
+		}
+		if err = cursor.All(ctx, &items); err != nil {
+			return nil, err
+		}
+
+		drifts := []models.BalanceDrift{}
+		for _, item := range items {
+			if total := rebuilt[item.StockKey]; total != item.Quantity {
+				drifts = append(drifts, models.BalanceDrift{StockKey: item.StockKey, Recorded: item.Quantity, Rebuilt: total})
+			}
+			delete(rebuilt, item.StockKey)
+		}
+		for key, total := range rebuilt {
+			if total != 0 {
+				drifts = append(drifts, models.BalanceDrift{StockKey: key, Rebuilt: total})
+			}
+		}
+
+		if dryRun {
+			return drifts, nil
+		}
+
+		for _, drift := range drifts {
+			filter, err := keyFilter(drift.StockKey)
+			if err != nil {
+				return nil, err
+			}
+
+			_, err = r.StockCollection.UpdateOne(ctx, filter, bson.M{
+				"$set":         bson.M{"quantity": drift.Rebuilt, "updated_at": time.Now()},
+				"$setOnInsert": bson.M{"reserved": int64(0), "in_transit": int64(0)},
+			}, options.Update().SetUpsert(true))
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		return drifts, nil
+	}, r.Logger)
+	if err != nil {
+		return nil, err
+	}
+
+	return res.([]models.BalanceDrift), nil
+}
+
+// DeleteEmptyForVariant removes the balances of a variant that hold nothing on hand, reserved or
+// in transit. Its movements stay in the ledger.
+func (r *stockRepository) DeleteEmptyForVariant(ctx context.Context, variantId string) error {
+	if _, err := objectId(variantId); err != nil {
+		return err
+	}
+
+	_, err := r.StockCollection.DeleteMany(ctx, emptyBalances(variantId))
+	return err
+}
+
+// emptyBalances matches the balances of a variant that hold nothing. Balances written before
+// reserved and in_transit were set on insert do not have them, which counts as zero.
+func emptyBalances(variantId string) bson.M {
+	return bson.M{
+		"variant_id": variantId,
+		"quantity":   0,
+		"reserved":   bson.M{"$in": bson.A{0, nil}},
+		"in_transit": bson.M{"$in": bson.A{0, nil}},
+	}
+}
+
+// HoldsStock reports whether any balance of the warehouse has something on hand, reserved or in
+// transit.
+func (r *stockRepository) HoldsStock(ctx context.Context, warehouseId string) (bool, error) {
+	if _, err := objectId(warehouseId); err != nil {
+		return false, err
+	}
+
+	count, err := r.StockCollection.CountDocuments(ctx, bson.M{
+		"warehouse_id": warehouseId,
+		"$or": bson.A{
+			bson.M{"quantity": bson.M{"$nin": bson.A{0, nil}}},
+			bson.M{"reserved": bson.M{"$nin": bson.A{0, nil}}},
+			bson.M{"in_transit": bson.M{"$nin": bson.A{0, nil}}},
+		},
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
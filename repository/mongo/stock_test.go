@@ -0,0 +1,62 @@
+package mongo
+
+import (
+	"fmt"
+	"go.mongodb.org/mongo-driver/bson"
+	"slices"
+	"testing"
+)
+
+// matches evaluates the equality and $in conditions of a filter against a document, treating a
+// missing field as null the way the server does.
+func matches(document, filter bson.M) bool {
+	for field, condition := range filter {
+		value := fmt.Sprint(document[field])
+		if operators, ok := condition.(bson.M); ok {
+			allowed := operators["$in"].(bson.A)
+			if !slices.ContainsFunc(allowed, func(candidate any) bool { return fmt.Sprint(candidate) == value }) {
+				return false
+			}
+			continue
+		}
+		if fmt.Sprint(condition) != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// TestEmptyBalancesAfterAdjust checks that a variant balance stocked and emptied again through
+// Adjust is removed with the variant.
+func TestEmptyBalancesAfterAdjust(t *testing.T) {
+	const variantId = "64b7f0c2a1b2c3d4e5f60718"
+
+	tests := []struct {
+		name     string
+		inserted bson.M
+	}{
+		{"written by Adjust", adjustUpdate(5)["$setOnInsert"].(bson.M)},
+		{"written before in_transit was set", bson.M{"reserved": int64(0)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			document := bson.M{"variant_id": variantId, "quantity": int64(0)}
+			for field, value := range test.inserted {
+				document[field] = value
+			}
+			for _, delta := range []int64{5, -5} {
+				document["quantity"] = document["quantity"].(int64) + adjustUpdate(delta)["$inc"].(bson.M)["quantity"].(int64)
+			}
+
+			if !matches(document, emptyBalances(variantId)) {
+				t.Errorf("emptyBalances() does not match %v", document)
+			}
+			document["quantity"] = int64(1)
+			if matches(document, emptyBalances(variantId)) {
+				t.Errorf("emptyBalances() matches %v", document)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"time"
+)
+
+type transferRepository struct {
+	Logger             zerolog.Logger
+	Client             *mongo.Client
+	TransferCollection *mongo.Collection
+	StockCollection    *mongo.Collection
+	MovementCollection *mongo.Collection
+	Tx                 Tx
+}
+
+func NewTransferRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.TransferRepository {
+	return &transferRepository{
+		Logger:             logger.With().Str("repository", repository.TransferCollection).Logger(),
+		Client:             database.Client(),
+		TransferCollection: database.Collection(repository.TransferCollection),
+		StockCollection:    database.Collection(repository.StockCollection),
+		MovementCollection: database.Collection(repository.MovementCollection),
+		Tx:                 txImpl,
+	}
+}
+
+func (r *transferRepository) InsertOne(ctx context.Context, transfer *models.Transfer) (string, error) {
+	if _, err := keyFilter(transfer.Source()); err != nil {
+		return "", err
+	}
+	if _, err := keyFilter(transfer.Destination()); err != nil {
+		return "", err
+	}
+
+	res, err := r.TransferCollection.InsertOne(ctx, transfer)
+	if err != nil {
+		return "", err
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *transferRepository) GetById(ctx context.Context, id string) (models.Transfer, error) {
+	transfer := models.Transfer{}
+
+	idObj, err := objectId(id)
+	if err != nil {
+		return transfer, err
+	}
+
+	err = r.TransferCollection.FindOne(ctx, bson.M{"_id": idObj}).Decode(&transfer)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return transfer, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return transfer, err
+	}
+
+	return transfer, nil
+}
+
+// transition moves a transfer from one status to the next and stamps the time of the step.
+func (r *transferRepository) transition(ctx context.Context, id primitive.ObjectID, from, to models.TransferStatus, stampField string) (models.Transfer, error) {
+	transfer := models.Transfer{}
+
+	filter := bson.M{"_id": id, "status": from}
+	update := bson.M{"$set": bson.M{"status": to, stampField: time.Now()}}
+	opts := options.FindOneAndUpdate().SetReturnDocument(options.After)
+
+	err := r.TransferCollection.FindOneAndUpdate(ctx, filter, update, opts).Decode(&transfer)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		count, err := r.TransferCollection.CountDocuments(ctx, bson.M{"_id": id})
+		if err != nil {
+			return transfer, err
+		}
+		if count == 0 {
+			return transfer, repository.ErrEntityNotFound
+		}
+		return transfer, repository.ErrTransferStatus
+	}
+	if err != nil {
+		return transfer, err
+	}
+
+	return transfer, nil
+}
+
+// Ship takes the transfer quantity out of the source warehouse and books it as in-transit
+// at the destination. All writes, including the ledger entry, share one transaction.
+func (r *transferRepository) Ship(ctx context.Context, id, actor string) (models.Transfer, error) {
+	idObj, err := objectId(id)
+	if err != nil {
+		return models.Transfer{}, err
+	}
+
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		transfer, err := r.transition(ctx, idObj, models.TransferStatusCreated, models.TransferStatusShipped, "shipped_at")
+		if err != nil {
+			return nil, err
+		}
+
+		source, err := keyFilter(transfer.Source())
+		if err != nil {
+			return nil, err
+		}
+		source["$expr"] = availableAtLeast(transfer.Quantity)
+
+		updated, err := r.StockCollection.UpdateOne(ctx, source, bson.M{
+			"$inc": bson.M{"quantity": -transfer.Quantity},
+			"$set": bson.M{"updated_at": time.Now()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if updated.MatchedCount == 0 {
+			return nil, repository.ErrInsufficientStock
+		}
+
+		err = insertMovement(ctx, r.MovementCollection, &models.StockMovement{
+			StockKey:   transfer.Source(),
+			Reason:     models.MovementReasonTransfer,
+			Delta:      -transfer.Quantity,
+			Actor:      actor,
+			TransferId: transfer.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		destination, err := keyFilter(transfer.Destination())
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = r.StockCollection.UpdateOne(ctx, destination, bson.M{
+			"$inc":         bson.M{"in_transit": transfer.Quantity},
+			"$set":         bson.M{"updated_at": time.Now()},
+			"$setOnInsert": bson.M{"quantity": int64(0), "reserved": int64(0)},
+		}, options.Update().SetUpsert(true))
+		if err != nil {
+			return nil, err
+		}
+
+		return transfer, nil
+	}, r.Logger)
+	if err != nil {
+		return models.Transfer{}, err
+	}
+
+	return res.(models.Transfer), nil
+}
+
+// Receive moves the in-transit quantity of a shipped transfer into the destination stock.
+func (r *transferRepository) Receive(ctx context.Context, id, actor string) (models.Transfer, error) {
+	idObj, err := objectId(id)
+	if err != nil {
+		return models.Transfer{}, err
+	}
+
+	res, err := r.Tx(ctx, r.Client, func(ctx context.Context) (any, error) {
+		transfer, err := r.transition(ctx, idObj, models.TransferStatusShipped, models.TransferStatusReceived, "received_at")
+		if err != nil {
+			return nil, err
+		}
+
+		destination, err := keyFilter(transfer.Destination())
+		if err != nil {
+			return nil, err
+		}
+		destination["in_transit"] = bson.M{"$gte": transfer.Quantity}
+
+		updated, err := r.StockCollection.UpdateOne(ctx, destination, bson.M{
+			"$inc": bson.M{"quantity": transfer.Quantity, "in_transit": -transfer.Quantity},
+			"$set": bson.M{"updated_at": time.Now()},
+		})
+		if err != nil {
+			return nil, err
+		}
+		if updated.MatchedCount == 0 {
+			return nil, repository.ErrInsufficientStock
+		}
+
+		err = insertMovement(ctx, r.MovementCollection, &models.StockMovement{
+			StockKey:   transfer.Destination(),
+			Reason:     models.MovementReasonTransfer,
+			Delta:      transfer.Quantity,
+			Actor:      actor,
+			TransferId: transfer.Id,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return transfer, nil
+	}, r.Logger)
+	if err != nil {
+		return models.Transfer{}, err
+	}
+
+	return res.(models.Transfer), nil
+}
@@ -2,6 +2,7 @@ package mongo
 
 import (
 	"context"
+	"github.com/igntnk/stocky_iims/repository"
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/mongo"
 )
@@ -19,6 +20,10 @@ var txImpl = func(
 	f func(ctx2 context.Context) (any, error),
 	logger zerolog.Logger,
 ) (any, error) {
+	// A repository called inside a running transaction joins it instead of starting its own.
+	if mongo.SessionFromContext(ctx) != nil {
+		return f(ctx)
+	}
 
 	callback := func(sesctx mongo.SessionContext) (interface{}, error) {
 		i, err := f(sesctx)
@@ -35,12 +40,24 @@ var txImpl = func(
 	return i, err
 }
 
-var noTxImpl = func(
-	ctx context.Context,
-	cl *mongo.Client,
-	f func(ctx2 context.Context) (any, error),
-	logger zerolog.Logger,
-) (any, error) {
-	logger.Warn().Msg("no transaction implementation")
-	return txImpl(ctx, cl, f, logger)
+type transactor struct {
+	Logger zerolog.Logger
+	Client *mongo.Client
+	Tx     Tx
+}
+
+func NewTransactor(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.Transactor {
+	return &transactor{
+		Logger: logger.With().Str("repository", "transactor").Logger(),
+		Client: database.Client(),
+		Tx:     txImpl,
+	}
+}
+
+func (t *transactor) WithTx(ctx context.Context, f func(context.Context) error) error {
+	_, err := t.Tx(ctx, t.Client, func(ctx context.Context) (any, error) {
+		return nil, f(ctx)
+	}, t.Logger)
+
+	return err
 }
@@ -0,0 +1,144 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type variantRepository struct {
+	Logger            zerolog.Logger
+	VariantCollection *mongo.Collection
+	Tx                Tx
+}
+
+func NewVariantRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.VariantRepository {
+	return &variantRepository{
+		Logger:            logger.With().Str("repository", repository.VariantCollection).Logger(),
+		VariantCollection: database.Collection(repository.VariantCollection),
+		Tx:                txImpl,
+	}
+}
+
+func (r *variantRepository) InsertOne(ctx context.Context, variant *models.Variant) (string, error) {
+	if _, err := objectId(variant.ProductId); err != nil {
+		return "", err
+	}
+
+	res, err := r.VariantCollection.InsertOne(ctx, variant)
+	if mongo.IsDuplicateKeyError(err) {
+		return "", repository.ErrAlreadyExists
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *variantRepository) findOne(ctx context.Context, filter bson.M) (models.Variant, error) {
+	variant := models.Variant{}
+
+	err := r.VariantCollection.FindOne(ctx, filter).Decode(&variant)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return variant, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return variant, err
+	}
+
+	return variant, nil
+}
+
+func (r *variantRepository) GetById(ctx context.Context, id string) (models.Variant, error) {
+	idObj, err := objectId(id)
+	if err != nil {
+		return models.Variant{}, err
+	}
+
+	return r.findOne(ctx, bson.M{"_id": idObj})
+}
+
+func (r *variantRepository) GetByProduct(ctx context.Context, productId string) ([]models.Variant, error) {
+	variants := []models.Variant{}
+
+	res, err := r.VariantCollection.Find(ctx, bson.M{"product_id": productId})
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &variants)
+	if err != nil {
+		return nil, err
+	}
+
+	return variants, nil
+}
+
+func (r *variantRepository) GetByProductCode(ctx context.Context, code string) (models.Variant, error) {
+	return r.findOne(ctx, bson.M{"product_code": code})
+}
+
+func (r *variantRepository) GetByBarcode(ctx context.Context, barcode string) (models.Variant, error) {
+	return r.findOne(ctx, bson.M{"barcode": barcode})
+}
+
+func (r *variantRepository) Update(ctx context.Context, variant *models.Variant) error {
+	id, err := objectId(variant.Id)
+	if err != nil {
+		return err
+	}
+
+	update := bson.M{"$set": bson.M{"attributes": variant.Attributes}}
+	set := update["$set"].(bson.M)
+	unset := bson.M{}
+	if variant.ProductCode != "" {
+		set["product_code"] = variant.ProductCode
+	}
+	if variant.Barcode != "" {
+		set["barcode"] = variant.Barcode
+	}
+	if variant.Price != nil {
+		set["price"] = *variant.Price
+	} else {
+		unset["price"] = ""
+	}
+	if len(unset) > 0 {
+		update["$unset"] = unset
+	}
+
+	res, err := r.VariantCollection.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return repository.ErrAlreadyExists
+	}
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *variantRepository) Delete(ctx context.Context, id string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.VariantCollection.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
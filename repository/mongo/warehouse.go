@@ -0,0 +1,138 @@
+package mongo
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type warehouseRepository struct {
+	Logger              zerolog.Logger
+	WarehouseCollection *mongo.Collection
+	Tx                  Tx
+}
+
+func NewWarehouseRepository(ctx context.Context, database *mongo.Database, logger zerolog.Logger) repository.WarehouseRepository {
+	return &warehouseRepository{
+		Logger:              logger.With().Str("repository", repository.WarehouseCollection).Logger(),
+		WarehouseCollection: database.Collection(repository.WarehouseCollection),
+		Tx:                  txImpl,
+	}
+}
+
+func (r *warehouseRepository) InsertOne(ctx context.Context, warehouse *models.Warehouse) (string, error) {
+	res, err := r.WarehouseCollection.InsertOne(ctx, warehouse)
+	if err != nil {
+		return "", err
+	}
+
+	return res.InsertedID.(primitive.ObjectID).Hex(), nil
+}
+
+func (r *warehouseRepository) Get(ctx context.Context, limit, offset int64) ([]models.Warehouse, error) {
+	warehouses := []models.Warehouse{}
+	pipeline := getPipeline(limit, offset)
+	res, err := r.WarehouseCollection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Close(ctx)
+
+	err = res.All(ctx, &warehouses)
+	if err != nil {
+		return nil, err
+	}
+
+	return warehouses, nil
+}
+
+func (r *warehouseRepository) GetById(ctx context.Context, id string) (models.Warehouse, error) {
+	warehouse := models.Warehouse{}
+
+	idObj, err := objectId(id)
+	if err != nil {
+		return warehouse, err
+	}
+
+	err = r.WarehouseCollection.FindOne(ctx, bson.M{"_id": idObj}).Decode(&warehouse)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return warehouse, repository.ErrEntityNotFound
+	}
+	if err != nil {
+		return warehouse, err
+	}
+
+	return warehouse, nil
+}
+
+func (r *warehouseRepository) Delete(ctx context.Context, id string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+	res, err := r.WarehouseCollection.DeleteOne(ctx, bson.M{"_id": idObj})
+	if err != nil {
+		return err
+	}
+	if res.DeletedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) Update(ctx context.Context, warehouse *models.Warehouse) error {
+	id, err := objectId(warehouse.Id)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.WarehouseCollection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{
+		"name":    warehouse.Name,
+		"address": warehouse.Address,
+	}})
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) BlockWarehouse(ctx context.Context, id string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.WarehouseCollection.UpdateOne(ctx, bson.M{"_id": idObj}, bson.M{"$set": bson.M{"blocked": true}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
+
+func (r *warehouseRepository) UnblockWarehouse(ctx context.Context, id string) error {
+	idObj, err := objectId(id)
+	if err != nil {
+		return err
+	}
+
+	res, err := r.WarehouseCollection.UpdateOne(ctx, bson.M{"_id": idObj}, bson.M{"$set": bson.M{"blocked": false}})
+	if err != nil {
+		return err
+	}
+	if res.MatchedCount == 0 {
+		return repository.ErrEntityNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+)
+
+const (
+	MovementCollection = "stock_movements"
+)
+
+type MovementRepository interface {
+	Get(context.Context, models.MovementFilter, int64, int64) ([]models.StockMovement, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"time"
+)
+
+const (
+	PriceHistoryCollection = "price_history"
+)
+
+type PriceRepository interface {
+	Apply(context.Context, *models.PriceChange) error
+	Schedule(context.Context, *models.PriceChange) (string, error)
+	GetDue(context.Context, time.Time, int64) ([]models.PriceChange, error)
+	// Fail marks a scheduled change that can never be applied, so that it is not retried.
+	Fail(context.Context, string) error
+	GetAt(context.Context, string, time.Time) (models.PriceChange, error)
+}
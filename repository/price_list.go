@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"time"
+)
+
+const (
+	PriceListCollection = "price_lists"
+)
+
+type PriceListRepository interface {
+	InsertOne(context.Context, *models.PriceList) (string, error)
+	Get(context.Context, models.SalesChannel, int64, int64) ([]models.PriceList, error)
+	GetById(context.Context, string) (models.PriceList, error)
+	GetApplicable(context.Context, string, models.SalesChannel, time.Time) ([]models.PriceList, error)
+	Update(context.Context, *models.PriceList) error
+	Delete(context.Context, string) error
+}
@@ -3,19 +3,32 @@ package repository
 import (
 	"context"
 	"github.com/igntnk/stocky_iims/models"
+	"time"
 )
 
 const (
 	ProductCollection = "products"
 )
 
+// ProductRepository leaves soft-deleted products out of every lookup, except GetById and
+// GetByProductCode when they are asked to include them. Delete only marks a product as deleted;
+// Purge removes a deleted product for good.
 type ProductRepository interface {
 	InsertOne(context.Context, *models.Product) (string, error)
-	Get(context.Context, int64, int64) ([]models.Product, error)
-	GetById(context.Context, string) (models.Product, error)
-	GetByProductCode(context.Context, string) (models.Product, error)
+	Get(context.Context, models.ProductFilter, models.PageRequest) ([]models.Product, models.PageInfo, error)
+	GetById(context.Context, string, bool) (models.Product, error)
+	GetByProductCode(context.Context, string, bool) (models.Product, error)
+	GetByBarcode(context.Context, string) (models.Product, error)
+	// Search leaves blocked products out unless asked to include them.
+	Search(context.Context, string, bool, int64, int64) ([]models.ScoredProduct, error)
+	Count(context.Context, models.ProductFilter) (int64, error)
 	Delete(context.Context, string) error
+	Restore(context.Context, string) error
+	Purge(context.Context, string) error
+	// GetDeletedBefore returns the products deleted before the time.
+	GetDeletedBefore(context.Context, time.Time) ([]models.Product, error)
 	Update(context.Context, *models.Product) error
-	BlockProduct(context.Context, string) error
+	// BlockProduct blocks a product on behalf of an actor for a reason, either of which may be empty.
+	BlockProduct(context.Context, string, string, string) error
 	UnblockProduct(context.Context, string) error
 }
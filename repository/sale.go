@@ -3,17 +3,46 @@ package repository
 import (
 	"context"
 	"github.com/igntnk/stocky_iims/models"
+	"time"
 )
 
 const (
-	SaleCollection = "products"
+	SaleCollection = "sales"
 )
 
+// SaleRepository leaves soft-deleted sales out unless asked to include them. Delete only marks a
+// sale as deleted; Purge removes a deleted sale for good.
 type SaleRepository interface {
 	InsertOne(context.Context, *models.Sale) (string, error)
-	Get(context.Context, int64, int64) ([]models.Sale, error)
+	GetById(context.Context, string, bool) (models.Sale, error)
+	Get(context.Context, models.SaleFilter, models.PageRequest) ([]models.Sale, models.PageInfo, error)
 	Delete(context.Context, string) error
+	Restore(context.Context, string) error
+	// Purge removes a deleted sale for good together with its coupons. PurgeDeletedBefore does
+	// the same for the sales deleted before the time and returns how many there were.
+	Purge(context.Context, string) error
+	PurgeDeletedBefore(context.Context, time.Time) (int64, error)
 	Update(context.Context, *models.Sale) error
-	BlockSale(context.Context, string) error
+	// BlockSale blocks a sale on behalf of an actor for a reason, either of which may be empty.
+	BlockSale(context.Context, string, string, string) error
 	UnblockSale(context.Context, string) error
+	GetStatusDue(context.Context, time.Time) ([]models.Sale, error)
+	SetStatus(context.Context, string, models.SaleStatus, models.SaleStatus) (bool, error)
+	GetActiveForProducts(context.Context, []string, []string, time.Time) ([]models.Sale, error)
+	// GetForProduct returns the sales that name the filter product as a target or in a bundle.
+	// The category path of the filter is not used.
+	GetForProduct(context.Context, models.SaleFilter, models.PageRequest) ([]models.Sale, models.PageInfo, error)
+	// BlockForProduct blocks the sales that refer to the product for a reason, leaving sales
+	// that are already blocked as they are. UnblockForProduct unblocks the sales it blocked,
+	// unless they were blocked or unblocked by hand since.
+	BlockForProduct(context.Context, string, string) (int64, error)
+	UnblockForProduct(context.Context, string) (int64, error)
+	// DeleteForProduct deletes the sales left without targets by the product and detaches the
+	// product from the others. RestoreForProduct undoes both.
+	DeleteForProduct(context.Context, string) (int64, error)
+	RestoreForProduct(context.Context, string) (int64, error)
+	// ForgetProduct drops what the sales still keep about a purged product: its targets, bundle
+	// places, detached targets and exclusions. Bundles with the product and product sales left
+	// without targets are deleted.
+	ForgetProduct(context.Context, string) error
 }
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+)
+
+const (
+	StockCollection = "stock"
+)
+
+type StockRepository interface {
+	Get(context.Context, models.StockKey) (models.StockItem, error)
+	GetByProduct(context.Context, string) ([]models.StockItem, error)
+	Adjust(context.Context, *models.StockMovement) (models.StockItem, error)
+	Reserve(context.Context, models.StockKey, int64) (models.StockItem, error)
+	Release(context.Context, models.StockKey, int64) (models.StockItem, error)
+	RebuildBalances(context.Context, string, bool) ([]models.BalanceDrift, error)
+	DeleteEmptyForVariant(context.Context, string) error
+	HoldsStock(context.Context, string) (bool, error)
+}
@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+)
+
+const (
+	TransferCollection = "transfers"
+)
+
+type TransferRepository interface {
+	InsertOne(context.Context, *models.Transfer) (string, error)
+	GetById(context.Context, string) (models.Transfer, error)
+	Ship(context.Context, string, string) (models.Transfer, error)
+	Receive(context.Context, string, string) (models.Transfer, error)
+}
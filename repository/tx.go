@@ -0,0 +1,9 @@
+package repository
+
+import "context"
+
+// Transactor runs a function in one transaction, so that the writes of several repositories
+// made inside it commit or roll back together.
+type Transactor interface {
+	WithTx(context.Context, func(context.Context) error) error
+}
@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+)
+
+const (
+	VariantCollection = "product_variants"
+)
+
+type VariantRepository interface {
+	InsertOne(context.Context, *models.Variant) (string, error)
+	GetById(context.Context, string) (models.Variant, error)
+	GetByProduct(context.Context, string) ([]models.Variant, error)
+	GetByProductCode(context.Context, string) (models.Variant, error)
+	GetByBarcode(context.Context, string) (models.Variant, error)
+	Update(context.Context, *models.Variant) error
+	Delete(context.Context, string) error
+}
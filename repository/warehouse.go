@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+)
+
+const (
+	WarehouseCollection = "warehouses"
+)
+
+type WarehouseRepository interface {
+	InsertOne(context.Context, *models.Warehouse) (string, error)
+	Get(context.Context, int64, int64) ([]models.Warehouse, error)
+	GetById(context.Context, string) (models.Warehouse, error)
+	Delete(context.Context, string) error
+	Update(context.Context, *models.Warehouse) error
+	BlockWarehouse(context.Context, string) error
+	UnblockWarehouse(context.Context, string) error
+}
@@ -0,0 +1,287 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type AttributeService interface {
+	InsertOne(context.Context, *pb.InsertAttributeRequest) (*pb.InsertAttributeResponse, error)
+	Get(context.Context, *pb.GetAttributesRequest) (*pb.GetAttributesResponse, error)
+	Update(context.Context, *pb.UpdateAttributeRequest) error
+	Delete(context.Context, *pb.DeleteAttributeRequest) error
+}
+
+type attributeService struct {
+	Logger       zerolog.Logger
+	repo         repository.AttributeRepository
+	categoryRepo repository.CategoryRepository
+}
+
+func NewAttributeService(logger zerolog.Logger, repo repository.AttributeRepository, categoryRepo repository.CategoryRepository) AttributeService {
+	return &attributeService{
+		Logger:       logger,
+		repo:         repo,
+		categoryRepo: categoryRepo,
+	}
+}
+
+var attributeTypes = map[pb.AttributeType]models.AttributeType{
+	pb.AttributeType_ATTRIBUTE_TYPE_STRING: models.AttributeTypeString,
+	pb.AttributeType_ATTRIBUTE_TYPE_NUMBER: models.AttributeTypeNumber,
+	pb.AttributeType_ATTRIBUTE_TYPE_BOOL:   models.AttributeTypeBool,
+	pb.AttributeType_ATTRIBUTE_TYPE_ENUM:   models.AttributeTypeEnum,
+}
+
+func attributeTypeMessage(attributeType models.AttributeType) pb.AttributeType {
+	for message, t := range attributeTypes {
+		if t == attributeType {
+			return message
+		}
+	}
+
+	return pb.AttributeType_ATTRIBUTE_TYPE_UNSPECIFIED
+}
+
+func attributeMessage(definition models.AttributeDefinition) *pb.GetAttributeMessage {
+	return &pb.GetAttributeMessage{
+		Id:            definition.Id,
+		CategoryId:    definition.CategoryId,
+		Name:          definition.Name,
+		Type:          attributeTypeMessage(definition.Type),
+		Required:      definition.Required,
+		AllowedValues: definition.AllowedValues,
+	}
+}
+
+// categoryAttributes returns the attribute definitions that apply to products of a category,
+// including the ones inherited from its ancestors.
+func categoryAttributes(ctx context.Context, attributeRepo repository.AttributeRepository, categoryRepo repository.CategoryRepository, categoryId string) ([]models.AttributeDefinition, error) {
+	if categoryId == "" {
+		return nil, nil
+	}
+
+	category, err := categoryRepo.GetById(ctx, categoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return attributeRepo.GetByCategories(ctx, category.Path())
+}
+
+// validAttributeName reports whether a name can be stored as a key of the product attributes.
+func validAttributeName(name string) bool {
+	return name != "" && name == strings.TrimSpace(name) && !strings.ContainsAny(name, ".$")
+}
+
+func definesAttribute(definitions []models.AttributeDefinition, name string) bool {
+	return slices.ContainsFunc(definitions, func(definition models.AttributeDefinition) bool {
+		return definition.Name == name
+	})
+}
+
+// checkAllowedValues makes sure enum attributes list their values and other types list none.
+func checkAllowedValues(attributeType models.AttributeType, values []string) error {
+	if attributeType == models.AttributeTypeEnum && len(values) == 0 {
+		return fmt.Errorf("%w: enum attribute has no allowed values", ErrInvalidAttributeDefinition)
+	}
+	if attributeType != models.AttributeTypeEnum && len(values) > 0 {
+		return fmt.Errorf("%w: only enum attributes have allowed values", ErrInvalidAttributeDefinition)
+	}
+
+	return nil
+}
+
+func (a attributeService) InsertOne(ctx context.Context, request *pb.InsertAttributeRequest) (*pb.InsertAttributeResponse, error) {
+	name := strings.TrimSpace(request.GetName())
+	if !validAttributeName(name) {
+		return nil, fmt.Errorf("%w: name %q", ErrInvalidAttributeDefinition, request.GetName())
+	}
+	if request.GetCategoryId() == "" {
+		return nil, fmt.Errorf("%w: no category", ErrInvalidAttributeDefinition)
+	}
+
+	attributeType, ok := attributeTypes[request.GetType()]
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown type", ErrInvalidAttributeDefinition)
+	}
+	if err := checkAllowedValues(attributeType, request.GetAllowedValues()); err != nil {
+		return nil, err
+	}
+
+	inherited, err := categoryAttributes(ctx, a.repo, a.categoryRepo, request.GetCategoryId())
+	if err != nil {
+		return nil, err
+	}
+	for _, definition := range inherited {
+		if definition.Name == name {
+			return nil, repository.ErrAlreadyExists
+		}
+	}
+
+	id, err := a.repo.InsertOne(ctx, &models.AttributeDefinition{
+		CategoryId:    request.GetCategoryId(),
+		Name:          name,
+		Type:          attributeType,
+		Required:      request.GetRequired(),
+		AllowedValues: request.GetAllowedValues(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InsertAttributeResponse{Id: id}, nil
+}
+
+func (a attributeService) Get(ctx context.Context, request *pb.GetAttributesRequest) (*pb.GetAttributesResponse, error) {
+	definitions, err := categoryAttributes(ctx, a.repo, a.categoryRepo, request.GetCategoryId())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.GetAttributeMessage, len(definitions))
+	for i, definition := range definitions {
+		result[i] = attributeMessage(definition)
+	}
+
+	return &pb.GetAttributesResponse{
+		Attributes: result,
+	}, nil
+}
+
+// Update changes whether an attribute is required and which values an enum allows. Name and
+// type stay fixed, since products already store values of that type under that name.
+func (a attributeService) Update(ctx context.Context, request *pb.UpdateAttributeRequest) error {
+	definition, err := a.repo.GetById(ctx, request.GetId())
+	if err != nil {
+		return err
+	}
+	if err = checkAllowedValues(definition.Type, request.GetAllowedValues()); err != nil {
+		return err
+	}
+
+	definition.Required = request.GetRequired()
+	definition.AllowedValues = request.GetAllowedValues()
+
+	return a.repo.Update(ctx, &definition)
+}
+
+func (a attributeService) Delete(ctx context.Context, request *pb.DeleteAttributeRequest) error {
+	return a.repo.Delete(ctx, request.GetId())
+}
+
+// validateAttributes checks product attribute values against the definitions of its category and
+// converts them to their typed form. An empty value leaves the attribute out.
+func validateAttributes(definitions []models.AttributeDefinition, values map[string]string) (map[string]any, error) {
+	result := map[string]any{}
+	known := map[string]bool{}
+
+	for _, definition := range definitions {
+		known[definition.Name] = true
+
+		value, ok := values[definition.Name]
+		if !ok || value == "" {
+			if definition.Required {
+				return nil, fmt.Errorf("%w: %q is required", ErrInvalidAttribute, definition.Name)
+			}
+			continue
+		}
+
+		switch definition.Type {
+		case models.AttributeTypeNumber:
+			number, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q must be a number", ErrInvalidAttribute, definition.Name)
+			}
+			result[definition.Name] = number
+		case models.AttributeTypeBool:
+			flag, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %q must be a bool", ErrInvalidAttribute, definition.Name)
+			}
+			result[definition.Name] = flag
+		case models.AttributeTypeEnum:
+			if !slices.Contains(definition.AllowedValues, value) {
+				return nil, fmt.Errorf("%w: %q does not allow %q", ErrInvalidAttribute, definition.Name, value)
+			}
+			result[definition.Name] = value
+		default:
+			result[definition.Name] = value
+		}
+	}
+
+	for name, value := range values {
+		if !known[name] && value != "" {
+			return nil, fmt.Errorf("%w: %q is not defined for the category", ErrInvalidAttribute, name)
+		}
+	}
+
+	return result, nil
+}
+
+// mergeAttributes lays the requested attribute values over the stored ones. Stored values the
+// definitions no longer cover are dropped, so that deleting a definition or moving the product to
+// another category does not make its next update fail.
+func mergeAttributes(definitions []models.AttributeDefinition, stored map[string]any, requested map[string]string) map[string]string {
+	result := map[string]string{}
+	for name, value := range attributeStrings(stored) {
+		if definesAttribute(definitions, name) {
+			result[name] = value
+		}
+	}
+	for name, value := range requested {
+		result[name] = value
+	}
+
+	return result
+}
+
+func attributeStrings(attributes map[string]any) map[string]string {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	result := make(map[string]string, len(attributes))
+	for name, value := range attributes {
+		switch v := value.(type) {
+		case float64:
+			result[name] = strconv.FormatFloat(v, 'f', -1, 64)
+		case bool:
+			result[name] = strconv.FormatBool(v)
+		default:
+			result[name] = fmt.Sprint(v)
+		}
+	}
+
+	return result
+}
+
+// attributeFilter turns requested attribute values into the stored forms they may have. The
+// schema differs between categories, so a value that parses as a number or a bool also matches
+// attributes stored with that type.
+func attributeFilter(values map[string]string) map[string][]any {
+	if len(values) == 0 {
+		return nil
+	}
+
+	result := make(map[string][]any, len(values))
+	for name, value := range values {
+		candidates := []any{value}
+		if number, err := strconv.ParseFloat(value, 64); err == nil {
+			candidates = append(candidates, number)
+		}
+		if value == "true" || value == "false" {
+			candidates = append(candidates, value == "true")
+		}
+		result[name] = candidates
+	}
+
+	return result
+}
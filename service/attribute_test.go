@@ -0,0 +1,107 @@
+package service
+
+import (
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"maps"
+	"reflect"
+	"testing"
+)
+
+var testAttributes = []models.AttributeDefinition{
+	{Name: "color", Type: models.AttributeTypeString, Required: true},
+	{Name: "weight", Type: models.AttributeTypeNumber},
+	{Name: "fragile", Type: models.AttributeTypeBool},
+	{Name: "size", Type: models.AttributeTypeEnum, AllowedValues: []string{"S", "M", "L"}},
+}
+
+func TestValidateAttributes(t *testing.T) {
+	tests := []struct {
+		name   string
+		values map[string]string
+		want   map[string]any
+		err    error
+	}{
+		{
+			name:   "typed values",
+			values: map[string]string{"color": "red", "weight": "1.5", "fragile": "true", "size": "M"},
+			want:   map[string]any{"color": "red", "weight": 1.5, "fragile": true, "size": "M"},
+		},
+		{
+			name:   "optional left out",
+			values: map[string]string{"color": "red"},
+			want:   map[string]any{"color": "red"},
+		},
+		{
+			name:   "empty value removes",
+			values: map[string]string{"color": "red", "weight": ""},
+			want:   map[string]any{"color": "red"},
+		},
+		{
+			name:   "empty unknown value",
+			values: map[string]string{"color": "red", "gone": ""},
+			want:   map[string]any{"color": "red"},
+		},
+		{"required missing", map[string]string{"weight": "1"}, nil, ErrInvalidAttribute},
+		{"required empty", map[string]string{"color": ""}, nil, ErrInvalidAttribute},
+		{"not a number", map[string]string{"color": "red", "weight": "heavy"}, nil, ErrInvalidAttribute},
+		{"not a bool", map[string]string{"color": "red", "fragile": "maybe"}, nil, ErrInvalidAttribute},
+		{"not allowed", map[string]string{"color": "red", "size": "XL"}, nil, ErrInvalidAttribute},
+		{"not defined", map[string]string{"color": "red", "gone": "1"}, nil, ErrInvalidAttribute},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := validateAttributes(testAttributes, test.values)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("validateAttributes() error = %v, want %v", err, test.err)
+			}
+			if test.err == nil && !reflect.DeepEqual(got, test.want) {
+				t.Errorf("validateAttributes() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestMergeAttributes(t *testing.T) {
+	stored := map[string]any{"color": "red", "weight": 1.5, "gone": "x"}
+
+	tests := []struct {
+		name      string
+		requested map[string]string
+		want      map[string]string
+	}{
+		{"nothing requested", nil, map[string]string{"color": "red", "weight": "1.5"}},
+		{"value replaced", map[string]string{"color": "blue"}, map[string]string{"color": "blue", "weight": "1.5"}},
+		{"value added", map[string]string{"size": "S"}, map[string]string{"color": "red", "weight": "1.5", "size": "S"}},
+		{"value cleared", map[string]string{"weight": ""}, map[string]string{"color": "red", "weight": ""}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := mergeAttributes(testAttributes, stored, test.requested); !maps.Equal(got, test.want) {
+				t.Errorf("mergeAttributes() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestValidAttributeName(t *testing.T) {
+	tests := []struct {
+		name string
+		want bool
+	}{
+		{"color", true},
+		{"screen size", true},
+		{"", false},
+		{" color", false},
+		{"color.name", false},
+		{"$where", false},
+	}
+
+	for _, test := range tests {
+		if got := validAttributeName(test.name); got != test.want {
+			t.Errorf("validAttributeName(%q) = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
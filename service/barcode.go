@@ -0,0 +1,61 @@
+package service
+
+import (
+	"fmt"
+	"strings"
+)
+
+const gtinLength = 14
+
+// normalizeBarcode checks the length and check digit of an EAN-8, UPC-A, EAN-13 or GTIN-14
+// barcode and returns it zero-padded to GTIN-14, so the same item matches whichever
+// symbology the scanner reports.
+func normalizeBarcode(code string) (string, error) {
+	code = strings.TrimSpace(code)
+
+	switch len(code) {
+	case 8, 12, 13, 14:
+	default:
+		return "", fmt.Errorf("%w: %q has %d digits", ErrInvalidBarcode, code, len(code))
+	}
+
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		digit := code[i]
+		if digit < '0' || digit > '9' {
+			return "", fmt.Errorf("%w: %q is not numeric", ErrInvalidBarcode, code)
+		}
+
+		weight := 1
+		if (len(code)-2-i)%2 == 0 {
+			weight = 3
+		}
+		sum += int(digit-'0') * weight
+	}
+
+	check := code[len(code)-1]
+	if check < '0' || check > '9' || int(check-'0') != (10-sum%10)%10 {
+		return "", fmt.Errorf("%w: %q has a wrong check digit", ErrInvalidBarcode, code)
+	}
+
+	return strings.Repeat("0", gtinLength-len(code)) + code, nil
+}
+
+func normalizeBarcodes(codes []string) ([]string, error) {
+	result := make([]string, 0, len(codes))
+	seen := map[string]bool{}
+
+	for _, code := range codes {
+		normalized, err := normalizeBarcode(code)
+		if err != nil {
+			return nil, err
+		}
+		if seen[normalized] {
+			continue
+		}
+		seen[normalized] = true
+		result = append(result, normalized)
+	}
+
+	return result, nil
+}
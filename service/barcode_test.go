@@ -0,0 +1,58 @@
+package service
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestNormalizeBarcode(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    string
+		invalid bool
+	}{
+		{name: "EAN-8", code: "96385074", want: "00000096385074"},
+		{name: "UPC-A", code: "036000291452", want: "00036000291452"},
+		{name: "EAN-13", code: "4006381333931", want: "04006381333931"},
+		{name: "GTIN-14", code: "10012345678902", want: "10012345678902"},
+		{name: "surrounding space", code: " 4006381333931\n", want: "04006381333931"},
+		{name: "wrong check digit", code: "4006381333932", invalid: true},
+		{name: "non numeric", code: "40063813339A1", invalid: true},
+		{name: "non numeric check digit", code: "400638133393X", invalid: true},
+		{name: "wrong length", code: "400638133", invalid: true},
+		{name: "empty", code: "", invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := normalizeBarcode(test.code)
+			if test.invalid {
+				if !errors.Is(err, ErrInvalidBarcode) {
+					t.Errorf("normalizeBarcode(%q) error = %v, want %v", test.code, err, ErrInvalidBarcode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("normalizeBarcode(%q): %v", test.code, err)
+			}
+			if got != test.want {
+				t.Errorf("normalizeBarcode(%q) = %q, want %q", test.code, got, test.want)
+			}
+		})
+	}
+}
+
+// TestNormalizeBarcodesDeduplicates checks that the same item scanned in two symbologies is kept once.
+func TestNormalizeBarcodesDeduplicates(t *testing.T) {
+	got, err := normalizeBarcodes([]string{"4006381333931", "04006381333931", "96385074"})
+	if err != nil {
+		t.Fatalf("normalizeBarcodes: %v", err)
+	}
+
+	want := []string{"04006381333931", "00000096385074"}
+	if !slices.Equal(got, want) {
+		t.Errorf("normalizeBarcodes() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"slices"
+	"sort"
+)
+
+type CategoryService interface {
+	InsertOne(context.Context, *pb.InsertCategoryRequest) (*pb.InsertCategoryResponse, error)
+	Get(context.Context, *pb.GetCategoriesRequest) (*pb.GetCategoriesResponse, error)
+	GetById(context.Context, *pb.GetByIdCategoryRequest) (*pb.GetCategoryMessage, error)
+	Delete(context.Context, *pb.DeleteCategoryRequest) error
+	Update(context.Context, *pb.UpdateCategoryRequest) error
+	GetTree(context.Context, *pb.GetCategoryTreeRequest) (*pb.GetCategoryTreeResponse, error)
+	MoveCategory(context.Context, *pb.MoveCategoryRequest) error
+}
+
+type categoryService struct {
+	Logger        zerolog.Logger
+	repo          repository.CategoryRepository
+	productRepo   repository.ProductRepository
+	attributeRepo repository.AttributeRepository
+}
+
+func NewCategoryService(logger zerolog.Logger, repo repository.CategoryRepository, productRepo repository.ProductRepository, attributeRepo repository.AttributeRepository) CategoryService {
+	return &categoryService{
+		Logger:        logger,
+		repo:          repo,
+		productRepo:   productRepo,
+		attributeRepo: attributeRepo,
+	}
+}
+
+func categoryMessage(category models.Category) *pb.GetCategoryMessage {
+	return &pb.GetCategoryMessage{
+		Id:          category.Id,
+		Name:        category.Name,
+		Description: category.Description,
+		ParentId:    category.ParentId,
+		Ancestors:   category.Ancestors,
+	}
+}
+
+// parentPath returns the ancestor list of a category placed under parentId.
+func (c categoryService) parentPath(ctx context.Context, parentId string) ([]string, error) {
+	if parentId == "" {
+		return []string{}, nil
+	}
+
+	parent, err := c.repo.GetById(ctx, parentId)
+	if err != nil {
+		return nil, err
+	}
+
+	return parent.Path(), nil
+}
+
+func (c categoryService) InsertOne(ctx context.Context, request *pb.InsertCategoryRequest) (*pb.InsertCategoryResponse, error) {
+	ancestors, err := c.parentPath(ctx, request.GetParentId())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := c.repo.InsertOne(ctx, &models.Category{
+		Name:        request.GetName(),
+		Description: request.GetDescription(),
+		ParentId:    request.GetParentId(),
+		Ancestors:   ancestors,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InsertCategoryResponse{Id: id}, nil
+}
+
+func (c categoryService) Get(ctx context.Context, request *pb.GetCategoriesRequest) (*pb.GetCategoriesResponse, error) {
+	categories, err := c.repo.Get(ctx, request.GetLimit(), request.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.GetCategoryMessage, len(categories))
+	for i, category := range categories {
+		result[i] = categoryMessage(category)
+	}
+
+	return &pb.GetCategoriesResponse{
+		Categories: result,
+	}, nil
+}
+
+func (c categoryService) GetById(ctx context.Context, request *pb.GetByIdCategoryRequest) (*pb.GetCategoryMessage, error) {
+	category, err := c.repo.GetById(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return categoryMessage(category), nil
+}
+
+// Delete removes a leaf category that no product refers to and that defines no attributes.
+func (c categoryService) Delete(ctx context.Context, request *pb.DeleteCategoryRequest) error {
+	subtree, err := c.repo.GetSubtree(ctx, request.GetId())
+	if err != nil {
+		return err
+	}
+	if len(subtree) > 1 {
+		return ErrCategoryNotEmpty
+	}
+
+	count, err := c.productRepo.Count(ctx, models.ProductFilter{
+		CategoryIds:    []string{request.GetId()},
+		IncludeBlocked: true,
+		IncludeDeleted: true,
+	})
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return ErrCategoryNotEmpty
+	}
+
+	definitions, err := c.attributeRepo.GetByCategories(ctx, []string{request.GetId()})
+	if err != nil {
+		return err
+	}
+	if len(definitions) > 0 {
+		return ErrCategoryNotEmpty
+	}
+
+	return c.repo.Delete(ctx, request.GetId())
+}
+
+func (c categoryService) Update(ctx context.Context, request *pb.UpdateCategoryRequest) error {
+	return c.repo.Update(ctx, &models.Category{
+		Id:          request.GetId(),
+		Name:        request.GetName(),
+		Description: request.GetDescription(),
+	})
+}
+
+// GetTree returns the subtree under RootId, or the whole forest when RootId is empty.
+// Siblings are ordered by name.
+func (c categoryService) GetTree(ctx context.Context, request *pb.GetCategoryTreeRequest) (*pb.GetCategoryTreeResponse, error) {
+	categories, err := c.repo.GetSubtree(ctx, request.GetRootId())
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Name < categories[j].Name
+	})
+
+	nodes := make(map[string]*pb.CategoryTreeNode, len(categories))
+	for _, category := range categories {
+		nodes[category.Id] = &pb.CategoryTreeNode{Category: categoryMessage(category)}
+	}
+
+	roots := []*pb.CategoryTreeNode{}
+	for _, category := range categories {
+		parent, ok := nodes[category.ParentId]
+		if !ok || category.Id == request.GetRootId() {
+			roots = append(roots, nodes[category.Id])
+			continue
+		}
+		parent.Children = append(parent.Children, nodes[category.Id])
+	}
+
+	return &pb.GetCategoryTreeResponse{
+		Roots: roots,
+	}, nil
+}
+
+func (c categoryService) MoveCategory(ctx context.Context, request *pb.MoveCategoryRequest) error {
+	ancestors, err := c.parentPath(ctx, request.GetParentId())
+	if err != nil {
+		return err
+	}
+	if slices.Contains(ancestors, request.GetId()) {
+		return ErrCategoryCycle
+	}
+
+	return c.repo.Move(ctx, request.GetId(), request.GetParentId(), ancestors)
+}
@@ -0,0 +1,318 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"strings"
+	"time"
+)
+
+const (
+	// couponAlphabet leaves out characters that are easy to mix up, and has 32 letters so a random
+	// byte maps onto it without bias.
+	couponAlphabet      = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	defaultCouponLength = 8
+	minCouponLength     = 6
+	maxCouponLength     = 32
+	// maxCouponCodeLength bounds a whole code, a generated one with its prefix included.
+	maxCouponCodeLength   = 64
+	maxGeneratedCoupons   = 10000
+	maxGenerationAttempts = 5
+)
+
+type CouponService interface {
+	InsertOne(context.Context, *pb.InsertCouponRequest) (*pb.InsertCouponResponse, error)
+	Generate(context.Context, *pb.GenerateCouponsRequest) (*pb.GenerateCouponsResponse, error)
+	ValidateCoupon(context.Context, *pb.CouponCodeRequest) (*pb.ValidateCouponResponse, error)
+	RedeemCoupon(context.Context, *pb.CouponCodeRequest) (*pb.RedeemCouponResponse, error)
+}
+
+type couponService struct {
+	Logger   zerolog.Logger
+	repo     repository.CouponRepository
+	saleRepo repository.SaleRepository
+}
+
+func NewCouponService(logger zerolog.Logger, repo repository.CouponRepository, saleRepo repository.SaleRepository) CouponService {
+	return &couponService{
+		Logger:   logger,
+		repo:     repo,
+		saleRepo: saleRepo,
+	}
+}
+
+var couponRejections = map[models.CouponRejection]pb.CouponRejection{
+	models.CouponRejectionExpired:       pb.CouponRejection_COUPON_REJECTION_EXPIRED,
+	models.CouponRejectionExhausted:     pb.CouponRejection_COUPON_REJECTION_EXHAUSTED,
+	models.CouponRejectionCustomerLimit: pb.CouponRejection_COUPON_REJECTION_CUSTOMER_LIMIT,
+	models.CouponRejectionSaleInactive:  pb.CouponRejection_COUPON_REJECTION_SALE_INACTIVE,
+}
+
+func couponMessage(coupon models.Coupon) *pb.CouponMessage {
+	return &pb.CouponMessage{
+		Id:             coupon.Id,
+		Code:           coupon.Code,
+		SaleId:         coupon.SaleId,
+		MaxRedemptions: coupon.MaxRedemptions,
+		MaxPerCustomer: coupon.MaxPerCustomer,
+		ExpiresAt:      optionalTimestamp(coupon.ExpiresAt),
+		Redemptions:    coupon.Redemptions,
+	}
+}
+
+// couponCode normalizes a code as typed by a customer. Codes are upper case letters, digits and dashes.
+func couponCode(code string) (string, error) {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	if code == "" || len(code) > maxCouponCodeLength {
+		return "", fmt.Errorf("%w: code %q", ErrInvalidCoupon, code)
+	}
+	for _, r := range code {
+		if (r < 'A' || r > 'Z') && (r < '0' || r > '9') && r != '-' {
+			return "", fmt.Errorf("%w: code %q", ErrInvalidCoupon, code)
+		}
+	}
+
+	return code, nil
+}
+
+// randomCode returns length random characters of couponAlphabet.
+func randomCode(length int) (string, error) {
+	buf := make([]byte, length)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = couponAlphabet[int(b)%len(couponAlphabet)]
+	}
+
+	return string(buf), nil
+}
+
+// newCoupon checks the sale and limits shared by inserted and generated coupons.
+func (s couponService) newCoupon(ctx context.Context, saleId string, maxRedemptions, maxPerCustomer int64, expiresAt *time.Time) (models.Coupon, error) {
+	if maxRedemptions < 0 || maxPerCustomer < 0 {
+		return models.Coupon{}, fmt.Errorf("%w: limits must not be negative", ErrInvalidCoupon)
+	}
+	if maxRedemptions > 0 && maxPerCustomer > maxRedemptions {
+		return models.Coupon{}, fmt.Errorf("%w: per customer limit is above the total limit", ErrInvalidCoupon)
+	}
+	if expiresAt != nil && !expiresAt.After(time.Now()) {
+		return models.Coupon{}, fmt.Errorf("%w: coupon has already expired", ErrInvalidCoupon)
+	}
+	if _, err := s.saleRepo.GetById(ctx, saleId, false); err != nil {
+		return models.Coupon{}, err
+	}
+
+	return models.Coupon{
+		SaleId:         saleId,
+		MaxRedemptions: maxRedemptions,
+		MaxPerCustomer: maxPerCustomer,
+		ExpiresAt:      expiresAt,
+		CreatedAt:      time.Now(),
+	}, nil
+}
+
+func (s couponService) InsertOne(ctx context.Context, request *pb.InsertCouponRequest) (*pb.InsertCouponResponse, error) {
+	code, err := couponCode(request.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	coupon, err := s.newCoupon(ctx, request.GetSaleId(), request.GetMaxRedemptions(), request.GetMaxPerCustomer(), optionalTime(request.GetExpiresAt()))
+	if err != nil {
+		return nil, err
+	}
+	coupon.Code = code
+
+	result, err := s.repo.InsertOne(ctx, &coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InsertCouponResponse{
+		Id: result,
+	}, nil
+}
+
+// Generate draws random codes until the requested number has been stored. Codes that are
+// already taken are drawn again, a few times at most.
+func (s couponService) Generate(ctx context.Context, request *pb.GenerateCouponsRequest) (*pb.GenerateCouponsResponse, error) {
+	count := int(request.GetCount())
+	if count <= 0 || count > maxGeneratedCoupons {
+		return nil, fmt.Errorf("%w: count must be between 1 and %d", ErrInvalidCoupon, maxGeneratedCoupons)
+	}
+
+	length := int(request.GetLength())
+	if length == 0 {
+		length = defaultCouponLength
+	}
+	if length < minCouponLength || length > maxCouponLength {
+		return nil, fmt.Errorf("%w: length must be between %d and %d", ErrInvalidCoupon, minCouponLength, maxCouponLength)
+	}
+
+	prefix := ""
+	if request.GetPrefix() != "" {
+		var err error
+		if prefix, err = couponCode(request.GetPrefix()); err != nil {
+			return nil, err
+		}
+	}
+	if len(prefix)+length > maxCouponCodeLength {
+		return nil, fmt.Errorf("%w: prefix and code together must not exceed %d characters", ErrInvalidCoupon, maxCouponCodeLength)
+	}
+
+	template, err := s.newCoupon(ctx, request.GetSaleId(), request.GetMaxRedemptions(), request.GetMaxPerCustomer(), optionalTime(request.GetExpiresAt()))
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, 0, count)
+	for attempt := 0; len(codes) < count; attempt++ {
+		if attempt == maxGenerationAttempts {
+			return nil, fmt.Errorf("%w: could not find %d free codes, try a longer code", ErrInvalidCoupon, count-len(codes))
+		}
+
+		drawn := map[string]bool{}
+		coupons := make([]models.Coupon, 0, count-len(codes))
+		for len(coupons) < count-len(codes) {
+			random, err := randomCode(length)
+			if err != nil {
+				return nil, err
+			}
+			if drawn[prefix+random] {
+				continue
+			}
+			drawn[prefix+random] = true
+
+			coupon := template
+			coupon.Code = prefix + random
+			coupons = append(coupons, coupon)
+		}
+
+		taken, err := s.repo.InsertMany(ctx, coupons)
+		if err != nil {
+			return nil, err
+		}
+		for _, code := range taken {
+			delete(drawn, code)
+		}
+		for _, coupon := range coupons {
+			if drawn[coupon.Code] {
+				codes = append(codes, coupon.Code)
+			}
+		}
+	}
+
+	return &pb.GenerateCouponsResponse{
+		Codes: codes,
+	}, nil
+}
+
+// rejection tells why a coupon cannot be redeemed by the customer now, if it cannot.
+func (s couponService) rejection(ctx context.Context, coupon models.Coupon, customerId string, now time.Time) (models.CouponRejection, error) {
+	if coupon.Expired(now) {
+		return models.CouponRejectionExpired, nil
+	}
+	if coupon.MaxRedemptions > 0 && coupon.Redemptions >= coupon.MaxRedemptions {
+		return models.CouponRejectionExhausted, nil
+	}
+
+	if coupon.MaxPerCustomer > 0 {
+		redemptions, err := s.repo.GetCustomerRedemptions(ctx, coupon.Id, customerId)
+		if err != nil {
+			return "", err
+		}
+		if redemptions >= coupon.MaxPerCustomer {
+			return models.CouponRejectionCustomerLimit, nil
+		}
+	}
+
+	sale, err := s.saleRepo.GetById(ctx, coupon.SaleId, false)
+	if errors.Is(err, repository.ErrEntityNotFound) {
+		return models.CouponRejectionSaleInactive, nil
+	}
+	if err != nil {
+		return "", err
+	}
+	if sale.Blocked || sale.StatusAt(now) != models.SaleStatusActive {
+		return models.CouponRejectionSaleInactive, nil
+	}
+
+	return "", nil
+}
+
+// coupon finds the coupon of a request and makes sure a customer is named when the coupon
+// is limited per customer.
+func (s couponService) coupon(ctx context.Context, request *pb.CouponCodeRequest) (models.Coupon, error) {
+	code, err := couponCode(request.GetCode())
+	if err != nil {
+		return models.Coupon{}, err
+	}
+
+	coupon, err := s.repo.GetByCode(ctx, code)
+	if err != nil {
+		return coupon, err
+	}
+	if coupon.MaxPerCustomer > 0 && request.GetCustomerId() == "" {
+		return coupon, fmt.Errorf("%w: customer is required", ErrInvalidCoupon)
+	}
+
+	return coupon, nil
+}
+
+func (s couponService) ValidateCoupon(ctx context.Context, request *pb.CouponCodeRequest) (*pb.ValidateCouponResponse, error) {
+	coupon, err := s.coupon(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	rejection, err := s.rejection(ctx, coupon, request.GetCustomerId(), time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ValidateCouponResponse{
+		Valid:     rejection == "",
+		Rejection: couponRejections[rejection],
+		Coupon:    couponMessage(coupon),
+	}, nil
+}
+
+// RedeemCoupon validates the coupon and counts the redemption. The limits are enforced again
+// by the repository, atomically, so concurrent checkouts cannot go past them.
+func (s couponService) RedeemCoupon(ctx context.Context, request *pb.CouponCodeRequest) (*pb.RedeemCouponResponse, error) {
+	coupon, err := s.coupon(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	rejection, err := s.rejection(ctx, coupon, request.GetCustomerId(), now)
+	if err != nil {
+		return nil, err
+	}
+	if rejection != "" {
+		return nil, fmt.Errorf("%w: %s", ErrCouponNotRedeemable, rejection)
+	}
+
+	redeemed, err := s.repo.Redeem(ctx, coupon, request.GetCustomerId(), now)
+	if errors.Is(err, repository.ErrRedemptionLimit) {
+		return nil, fmt.Errorf("%w: %s", ErrCouponNotRedeemable, models.CouponRejectionExhausted)
+	}
+	if errors.Is(err, repository.ErrCustomerRedemptionLimit) {
+		return nil, fmt.Errorf("%w: %s", ErrCouponNotRedeemable, models.CouponRejectionCustomerLimit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RedeemCouponResponse{
+		Coupon: couponMessage(redeemed),
+	}, nil
+}
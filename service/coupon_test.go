@@ -0,0 +1,96 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"strings"
+	"testing"
+)
+
+func TestCouponCode(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		want    string
+		invalid bool
+	}{
+		{name: "upper case", code: "SPRING-24", want: "SPRING-24"},
+		{name: "normalized", code: "  spring-24\n", want: "SPRING-24"},
+		{name: "longest", code: strings.Repeat("A", maxCouponCodeLength), want: strings.Repeat("A", maxCouponCodeLength)},
+		{name: "too long", code: strings.Repeat("A", maxCouponCodeLength+1), invalid: true},
+		{name: "empty", code: "", invalid: true},
+		{name: "blank", code: "   ", invalid: true},
+		{name: "inner space", code: "SPRING 24", invalid: true},
+		{name: "underscore", code: "SPRING_24", invalid: true},
+		{name: "non latin", code: "ВЕСНА", invalid: true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := couponCode(test.code)
+			if test.invalid {
+				if !errors.Is(err, ErrInvalidCoupon) {
+					t.Errorf("couponCode(%q) error = %v, want %v", test.code, err, ErrInvalidCoupon)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("couponCode(%q): %v", test.code, err)
+			}
+			if got != test.want {
+				t.Errorf("couponCode(%q) = %q, want %q", test.code, got, test.want)
+			}
+		})
+	}
+}
+
+// TestRandomCodeIsValidCode checks that every generated code, with the longest prefix it may
+// carry, reads back through couponCode unchanged.
+func TestRandomCodeIsValidCode(t *testing.T) {
+	for length := minCouponLength; length <= maxCouponLength; length++ {
+		random, err := randomCode(length)
+		if err != nil {
+			t.Fatalf("randomCode(%d): %v", length, err)
+		}
+		if len(random) != length {
+			t.Errorf("randomCode(%d) = %q, want %d characters", length, random, length)
+		}
+		if strings.Trim(random, couponAlphabet) != "" {
+			t.Errorf("randomCode(%d) = %q, want only %q", length, random, couponAlphabet)
+		}
+
+		code := strings.Repeat("P", maxCouponCodeLength-length) + random
+		if got, err := couponCode(code); err != nil || got != code {
+			t.Errorf("couponCode(%q) = %q, %v, want it unchanged", code, got, err)
+		}
+	}
+}
+
+func TestGenerateRejectsLongCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		prefix string
+		length int32
+	}{
+		{"prefix with default length", strings.Repeat("P", maxCouponCodeLength-defaultCouponLength+1), 0},
+		{"prefix with longest code", strings.Repeat("P", maxCouponCodeLength-maxCouponLength+1), maxCouponLength},
+		{"code too long", "", maxCouponLength + 1},
+		{"code too short", "", minCouponLength - 1},
+	}
+
+	service := couponService{}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := service.Generate(context.Background(), &pb.GenerateCouponsRequest{
+				SaleId: "sale",
+				Count:  1,
+				Prefix: test.prefix,
+				Length: test.length,
+			})
+			if !errors.Is(err, ErrInvalidCoupon) {
+				t.Errorf("Generate() error = %v, want %v", err, ErrInvalidCoupon)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import "github.com/igntnk/stocky_iims/models"
+
+var (
+	ErrInvalidQuantity            = models.NewError(models.ErrInvalidArgument, "quantity must be positive")
+	ErrSameWarehouse              = models.NewError(models.ErrInvalidArgument, "source and destination warehouses must differ")
+	ErrWarehouseBlocked           = models.NewError(models.ErrBlocked, "warehouse is blocked")
+	ErrWarehouseHasStock          = models.NewError(models.ErrPreconditionFailed, "warehouse still has stock")
+	ErrProductBlocked             = models.NewError(models.ErrBlocked, "product is blocked")
+	ErrInvalidMovementReason      = models.NewError(models.ErrInvalidArgument, "movement reason does not match the quantity change")
+	ErrInvalidBarcode             = models.NewError(models.ErrInvalidArgument, "invalid barcode")
+	ErrEmptySearchQuery           = models.NewError(models.ErrInvalidArgument, "search query is empty")
+	ErrCategoryNotEmpty           = models.NewError(models.ErrPreconditionFailed, "category has subcategories, products or attributes")
+	ErrCategoryCycle              = models.NewError(models.ErrPreconditionFailed, "category cannot be moved under itself or its descendant")
+	ErrVariantMismatch            = models.NewError(models.ErrInvalidArgument, "variant does not belong to the product")
+	ErrVariantHasStock            = models.NewError(models.ErrPreconditionFailed, "variant still has stock")
+	ErrInvalidAttribute           = models.NewError(models.ErrInvalidArgument, "invalid product attribute")
+	ErrInvalidAttributeDefinition = models.NewError(models.ErrInvalidArgument, "invalid attribute definition")
+	ErrInvalidMoney               = models.NewError(models.ErrInvalidArgument, "invalid money amount")
+	ErrCurrencyMismatch           = models.NewError(models.ErrInvalidArgument, "prices are in different currencies")
+	ErrInvalidEffectiveDate       = models.NewError(models.ErrInvalidArgument, "effective date must be in the future")
+	ErrInvalidSalesChannel        = models.NewError(models.ErrInvalidArgument, "unknown sales channel")
+	ErrInvalidPriceList           = models.NewError(models.ErrInvalidArgument, "invalid price list")
+	ErrInvalidSaleWindow          = models.NewError(models.ErrInvalidArgument, "sale must end after it starts")
+	ErrInvalidCombinePolicy       = models.NewError(models.ErrInvalidArgument, "unknown sale combine policy")
+	ErrEmptyBasket                = models.NewError(models.ErrInvalidArgument, "nothing to price")
+	ErrInvalidDiscount            = models.NewError(models.ErrInvalidArgument, "invalid discount")
+	ErrInvalidSaleTarget          = models.NewError(models.ErrInvalidArgument, "invalid sale target")
+	ErrInvalidCoupon              = models.NewError(models.ErrInvalidArgument, "invalid coupon")
+	ErrCouponNotRedeemable        = models.NewError(models.ErrPreconditionFailed, "coupon cannot be redeemed")
+	ErrProductHasSales            = models.NewError(models.ErrPreconditionFailed, "product is still referred to by sales")
+	ErrInvalidDeletePolicy        = models.NewError(models.ErrInvalidArgument, "unknown product delete policy")
+	ErrNotDeleted                 = models.NewError(models.ErrPreconditionFailed, "only deleted entities can be purged")
+	ErrSaleTargetGone             = models.NewError(models.ErrPreconditionFailed, "sale refers to products that no longer exist")
+)
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"fmt"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"math"
+	"strings"
+)
+
+// money converts a price from a request, checking that the currency is supported and the
+// amount is not negative.
+func money(message *pb.Money) (models.Money, error) {
+	if message == nil {
+		return models.Money{}, fmt.Errorf("%w: price is missing", ErrInvalidMoney)
+	}
+
+	currency := strings.ToUpper(message.GetCurrency())
+	if _, ok := models.MinorUnits(currency); !ok {
+		return models.Money{}, fmt.Errorf("%w: unsupported currency %q", ErrInvalidMoney, message.GetCurrency())
+	}
+	if message.GetAmount() < 0 {
+		return models.Money{}, fmt.Errorf("%w: amount is negative", ErrInvalidMoney)
+	}
+
+	return models.Money{Amount: message.GetAmount(), Currency: currency}, nil
+}
+
+// optionalMoney converts a price that a request may omit.
+func optionalMoney(message *pb.Money) (*models.Money, error) {
+	if message == nil {
+		return nil, nil
+	}
+
+	result, err := money(message)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// multiply returns a non-negative amount times a positive quantity, failing when the result does
+// not fit in an int64.
+func multiply(amount, quantity int64) (int64, error) {
+	if amount > math.MaxInt64/quantity {
+		return 0, fmt.Errorf("%w: %d times %d is too large", ErrInvalidMoney, amount, quantity)
+	}
+
+	return amount * quantity, nil
+}
+
+// add returns the sum of two non-negative amounts, failing when it does not fit in an int64.
+func add(a, b int64) (int64, error) {
+	if a > math.MaxInt64-b {
+		return 0, fmt.Errorf("%w: %d plus %d is too large", ErrInvalidMoney, a, b)
+	}
+
+	return a + b, nil
+}
+
+func moneyMessage(money models.Money) *pb.Money {
+	return &pb.Money{
+		Amount:   money.Amount,
+		Currency: money.Currency,
+	}
+}
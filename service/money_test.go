@@ -0,0 +1,61 @@
+package service
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestMultiply(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   int64
+		quantity int64
+		want     int64
+		err      error
+	}{
+		{"small", 1250, 4, 5000, nil},
+		{"zero amount", 0, math.MaxInt64, 0, nil},
+		{"largest", math.MaxInt64 / 2, 2, math.MaxInt64 - 1, nil},
+		{"overflow", math.MaxInt64/2 + 1, 2, 0, ErrInvalidMoney},
+		{"large quantity", 100, math.MaxInt64 / 10, 0, ErrInvalidMoney},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := multiply(test.amount, test.quantity)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("multiply(%d, %d) error = %v, want %v", test.amount, test.quantity, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("multiply(%d, %d) = %d, want %d", test.amount, test.quantity, got, test.want)
+			}
+		})
+	}
+}
+
+func TestAdd(t *testing.T) {
+	tests := []struct {
+		name string
+		a    int64
+		b    int64
+		want int64
+		err  error
+	}{
+		{"small", 1250, 4, 1254, nil},
+		{"largest", math.MaxInt64 - 1, 1, math.MaxInt64, nil},
+		{"overflow", math.MaxInt64, 1, 0, ErrInvalidMoney},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := add(test.a, test.b)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("add(%d, %d) error = %v, want %v", test.a, test.b, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("add(%d, %d) = %d, want %d", test.a, test.b, got, test.want)
+			}
+		})
+	}
+}
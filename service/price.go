@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+var priceChangeStatuses = map[models.PriceChangeStatus]pb.PriceChangeStatus{
+	models.PriceChangeScheduled: pb.PriceChangeStatus_PRICE_CHANGE_STATUS_SCHEDULED,
+	models.PriceChangeApplied:   pb.PriceChangeStatus_PRICE_CHANGE_STATUS_APPLIED,
+	models.PriceChangeFailed:    pb.PriceChangeStatus_PRICE_CHANGE_STATUS_FAILED,
+}
+
+func priceChangeMessage(change models.PriceChange) *pb.PriceChangeMessage {
+	message := &pb.PriceChangeMessage{
+		Id:            change.Id,
+		ProductId:     change.ProductId,
+		Price:         moneyMessage(change.Price),
+		Status:        priceChangeStatuses[change.Status],
+		EffectiveFrom: timestamppb.New(change.EffectiveFrom),
+	}
+	if change.EffectiveTo != nil {
+		message.EffectiveTo = timestamppb.New(*change.EffectiveTo)
+	}
+
+	return message
+}
+
+// SchedulePriceChange stores a future price that the price scheduler applies once its
+// effective time comes. The price stays in the product currency, which variant prices, price
+// lists and sale amounts are kept in.
+func (p productService) SchedulePriceChange(ctx context.Context, request *pb.SchedulePriceChangeRequest) (*pb.PriceChangeMessage, error) {
+	if request.GetEffectiveFrom() == nil || !request.GetEffectiveFrom().AsTime().After(time.Now()) {
+		return nil, ErrInvalidEffectiveDate
+	}
+
+	price, err := money(request.GetPrice())
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := p.repo.GetById(ctx, request.GetProductId(), false)
+	if err != nil {
+		return nil, err
+	}
+	if price.Currency != product.Price.Currency {
+		return nil, ErrCurrencyMismatch
+	}
+
+	change := models.PriceChange{
+		ProductId:     request.GetProductId(),
+		Price:         price,
+		EffectiveFrom: request.GetEffectiveFrom().AsTime(),
+	}
+	change.Id, err = p.priceRepo.Schedule(ctx, &change)
+	if err != nil {
+		return nil, err
+	}
+
+	return priceChangeMessage(change), nil
+}
+
+// GetPriceAt returns the price a product had at the given time. The time is required.
+func (p productService) GetPriceAt(ctx context.Context, request *pb.GetPriceAtRequest) (*pb.PriceChangeMessage, error) {
+	change, err := p.priceRepo.GetAt(ctx, request.GetProductId(), request.GetAt().AsTime())
+	if err != nil {
+		return nil, err
+	}
+
+	return priceChangeMessage(change), nil
+}
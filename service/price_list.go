@@ -0,0 +1,280 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type PriceListService interface {
+	InsertOne(context.Context, *pb.InsertPriceListRequest) (*pb.InsertPriceListResponse, error)
+	Get(context.Context, *pb.GetPriceListsRequest) (*pb.GetPriceListsResponse, error)
+	GetById(context.Context, *pb.GetByIdPriceListRequest) (*pb.GetPriceListMessage, error)
+	Update(context.Context, *pb.UpdatePriceListRequest) error
+	Delete(context.Context, *pb.DeletePriceListRequest) error
+	ResolvePrice(context.Context, *pb.ResolvePriceRequest) (*pb.ResolvePriceResponse, error)
+}
+
+type priceListService struct {
+	Logger      zerolog.Logger
+	repo        repository.PriceListRepository
+	productRepo repository.ProductRepository
+}
+
+func NewPriceListService(logger zerolog.Logger, repo repository.PriceListRepository, productRepo repository.ProductRepository) PriceListService {
+	return &priceListService{
+		Logger:      logger,
+		repo:        repo,
+		productRepo: productRepo,
+	}
+}
+
+var salesChannels = map[pb.SalesChannel]models.SalesChannel{
+	pb.SalesChannel_SALES_CHANNEL_RETAIL:    models.SalesChannelRetail,
+	pb.SalesChannel_SALES_CHANNEL_WHOLESALE: models.SalesChannelWholesale,
+	pb.SalesChannel_SALES_CHANNEL_ONLINE:    models.SalesChannelOnline,
+}
+
+func salesChannelMessage(channel models.SalesChannel) pb.SalesChannel {
+	for message, c := range salesChannels {
+		if c == channel {
+			return message
+		}
+	}
+
+	return pb.SalesChannel_SALES_CHANNEL_UNSPECIFIED
+}
+
+func salesChannel(channel pb.SalesChannel) (models.SalesChannel, error) {
+	result, ok := salesChannels[channel]
+	if !ok {
+		return "", ErrInvalidSalesChannel
+	}
+
+	return result, nil
+}
+
+func optionalTime(timestamp *timestamppb.Timestamp) *time.Time {
+	if timestamp == nil {
+		return nil
+	}
+
+	result := timestamp.AsTime()
+	return &result
+}
+
+func optionalTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+
+	return timestamppb.New(*t)
+}
+
+func priceListMessage(priceList models.PriceList) *pb.GetPriceListMessage {
+	items := make([]*pb.PriceListItemMessage, len(priceList.Items))
+	for i, item := range priceList.Items {
+		tiers := make([]*pb.PriceTierMessage, len(item.Tiers))
+		for j, tier := range item.Tiers {
+			tiers[j] = &pb.PriceTierMessage{
+				MinQuantity: tier.MinQuantity,
+				Price:       moneyMessage(tier.Price),
+			}
+		}
+		items[i] = &pb.PriceListItemMessage{
+			ProductId: item.ProductId,
+			Tiers:     tiers,
+		}
+	}
+
+	return &pb.GetPriceListMessage{
+		Id:        priceList.Id,
+		Name:      priceList.Name,
+		Channel:   salesChannelMessage(priceList.Channel),
+		Priority:  priceList.Priority,
+		ValidFrom: optionalTimestamp(priceList.ValidFrom),
+		ValidTo:   optionalTimestamp(priceList.ValidTo),
+		Items:     items,
+	}
+}
+
+// priceList builds a price list from request fields. Every product must exist and appear once,
+// tier quantities must be positive and distinct, and all prices share one currency, the one the
+// products are priced in.
+func (s priceListService) priceList(ctx context.Context, name string, channel pb.SalesChannel, priority int32, validFrom, validTo *timestamppb.Timestamp, items []*pb.PriceListItemMessage) (models.PriceList, error) {
+	result := models.PriceList{
+		Name:      name,
+		Priority:  priority,
+		ValidFrom: optionalTime(validFrom),
+		ValidTo:   optionalTime(validTo),
+		Items:     make([]models.PriceListItem, len(items)),
+	}
+
+	var err error
+	if result.Channel, err = salesChannel(channel); err != nil {
+		return result, err
+	}
+	if result.ValidFrom != nil && result.ValidTo != nil && !result.ValidTo.After(*result.ValidFrom) {
+		return result, fmt.Errorf("%w: validity ends before it starts", ErrInvalidPriceList)
+	}
+
+	currency := ""
+	products := map[string]bool{}
+	for i, item := range items {
+		if products[item.GetProductId()] {
+			return result, fmt.Errorf("%w: product %s is listed twice", ErrInvalidPriceList, item.GetProductId())
+		}
+		products[item.GetProductId()] = true
+
+		product, err := s.productRepo.GetById(ctx, item.GetProductId(), false)
+		if err != nil {
+			return result, err
+		}
+		if len(item.GetTiers()) == 0 {
+			return result, fmt.Errorf("%w: product %s has no prices", ErrInvalidPriceList, item.GetProductId())
+		}
+
+		quantities := map[int64]bool{}
+		tiers := make([]models.PriceTier, len(item.GetTiers()))
+		for j, tier := range item.GetTiers() {
+			if tier.GetMinQuantity() <= 0 || quantities[tier.GetMinQuantity()] {
+				return result, fmt.Errorf("%w: product %s has an invalid tier quantity", ErrInvalidPriceList, item.GetProductId())
+			}
+			quantities[tier.GetMinQuantity()] = true
+
+			price, err := money(tier.GetPrice())
+			if err != nil {
+				return result, err
+			}
+			if currency == "" {
+				currency = price.Currency
+			}
+			if price.Currency != currency || price.Currency != product.Price.Currency {
+				return result, ErrCurrencyMismatch
+			}
+
+			tiers[j] = models.PriceTier{MinQuantity: tier.GetMinQuantity(), Price: price}
+		}
+
+		result.Items[i] = models.PriceListItem{ProductId: item.GetProductId(), Tiers: tiers}
+	}
+
+	return result, nil
+}
+
+func (s priceListService) InsertOne(ctx context.Context, request *pb.InsertPriceListRequest) (*pb.InsertPriceListResponse, error) {
+	priceList, err := s.priceList(ctx, request.GetName(), request.GetChannel(), request.GetPriority(), request.GetValidFrom(), request.GetValidTo(), request.GetItems())
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := s.repo.InsertOne(ctx, &priceList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InsertPriceListResponse{Id: id}, nil
+}
+
+func (s priceListService) Get(ctx context.Context, request *pb.GetPriceListsRequest) (*pb.GetPriceListsResponse, error) {
+	channel := models.SalesChannel("")
+	if request.GetChannel() != pb.SalesChannel_SALES_CHANNEL_UNSPECIFIED {
+		var err error
+		if channel, err = salesChannel(request.GetChannel()); err != nil {
+			return nil, err
+		}
+	}
+
+	priceLists, err := s.repo.Get(ctx, channel, request.GetLimit(), request.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.GetPriceListMessage, len(priceLists))
+	for i, priceList := range priceLists {
+		result[i] = priceListMessage(priceList)
+	}
+
+	return &pb.GetPriceListsResponse{
+		PriceLists: result,
+	}, nil
+}
+
+func (s priceListService) GetById(ctx context.Context, request *pb.GetByIdPriceListRequest) (*pb.GetPriceListMessage, error) {
+	priceList, err := s.repo.GetById(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return priceListMessage(priceList), nil
+}
+
+func (s priceListService) Update(ctx context.Context, request *pb.UpdatePriceListRequest) error {
+	priceList, err := s.priceList(ctx, request.GetName(), request.GetChannel(), request.GetPriority(), request.GetValidFrom(), request.GetValidTo(), request.GetItems())
+	if err != nil {
+		return err
+	}
+	priceList.Id = request.GetId()
+
+	return s.repo.Update(ctx, &priceList)
+}
+
+func (s priceListService) Delete(ctx context.Context, request *pb.DeletePriceListRequest) error {
+	return s.repo.Delete(ctx, request.GetId())
+}
+
+// ResolvePrice picks the unit price of a product for a channel and quantity: the matching tier
+// of the highest priority list valid now, or the base product price when no list applies.
+func (s priceListService) ResolvePrice(ctx context.Context, request *pb.ResolvePriceRequest) (*pb.ResolvePriceResponse, error) {
+	if request.GetQuantity() <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	channel, err := salesChannel(request.GetChannel())
+	if err != nil {
+		return nil, err
+	}
+
+	product, err := sellableProduct(ctx, s.productRepo, request.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+
+	priceLists, err := s.repo.GetApplicable(ctx, request.GetProductId(), channel, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ResolvePriceResponse{ProductId: product.Id}
+	unitPrice := product.Price
+	for _, priceList := range priceLists {
+		item, ok := priceList.Item(product.Id)
+		if !ok {
+			continue
+		}
+		tier, ok := item.Tier(request.GetQuantity())
+		if !ok {
+			continue
+		}
+
+		unitPrice = tier.Price
+		result.PriceListId = priceList.Id
+		result.MinQuantity = tier.MinQuantity
+		break
+	}
+
+	total, err := multiply(unitPrice.Amount, request.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	result.UnitPrice = moneyMessage(unitPrice)
+	result.Total = moneyMessage(models.Money{Amount: total, Currency: unitPrice.Currency})
+
+	return result, nil
+}
@@ -0,0 +1,85 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+const priceSchedulerBatch = 100
+
+// PriceScheduler applies scheduled price changes when their effective time comes. It runs in the
+// server process; several instances may run at once, since each change is applied only once.
+type PriceScheduler struct {
+	Logger   zerolog.Logger
+	repo     repository.PriceRepository
+	interval time.Duration
+}
+
+func NewPriceScheduler(logger zerolog.Logger, repo repository.PriceRepository, interval time.Duration) *PriceScheduler {
+	return &PriceScheduler{
+		Logger:   logger.With().Str("worker", "price_scheduler").Logger(),
+		repo:     repo,
+		interval: interval,
+	}
+}
+
+// Run checks for due changes every interval until ctx is cancelled.
+func (s *PriceScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.applyDue(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// applyDue applies the due changes batch by batch. A change whose product is gone, or whose
+// currency is not the product currency, is marked failed; any other failure is left for the next
+// run, so that it does not hold up later changes.
+func (s *PriceScheduler) applyDue(ctx context.Context) {
+	for {
+		changes, err := s.repo.GetDue(ctx, time.Now(), priceSchedulerBatch)
+		if err != nil {
+			s.Logger.Error().Err(err).Msg("Failed to load due price changes")
+			return
+		}
+
+		retry := false
+		for _, change := range changes {
+			err = s.repo.Apply(ctx, &change)
+			if errors.Is(err, repository.ErrEntityNotFound) || errors.Is(err, repository.ErrCurrencyChanged) {
+				s.Logger.Warn().Err(err).Str("price_change_id", change.Id).Str("product_id", change.ProductId).Msg("Price change failed")
+				if err = s.repo.Fail(ctx, change.Id); err != nil {
+					s.Logger.Error().Err(err).Str("price_change_id", change.Id).Msg("Failed to mark price change as failed")
+					retry = true
+				}
+				continue
+			}
+			if err != nil {
+				s.Logger.Error().Err(err).Str("price_change_id", change.Id).Msg("Failed to apply price change")
+				retry = true
+				continue
+			}
+
+			s.Logger.Info().
+				Str("product_id", change.ProductId).
+				Int64("amount", change.Price.Amount).
+				Str("currency", change.Price.Currency).
+				Msg("Scheduled price applied")
+		}
+
+		// Changes left for the next run would come back in the next batch.
+		if retry || len(changes) < priceSchedulerBatch {
+			return
+		}
+	}
+}
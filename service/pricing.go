@@ -0,0 +1,469 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"maps"
+	"math/big"
+	"slices"
+	"time"
+)
+
+var saleCombinePolicies = map[pb.SaleCombinePolicy]models.SaleCombinePolicy{
+	pb.SaleCombinePolicy_SALE_COMBINE_POLICY_BEST_OF:   models.SaleCombineBestOf,
+	pb.SaleCombinePolicy_SALE_COMBINE_POLICY_STACK:     models.SaleCombineStack,
+	pb.SaleCombinePolicy_SALE_COMBINE_POLICY_EXCLUSIVE: models.SaleCombineExclusive,
+}
+
+func saleCombinePolicyMessage(policy models.SaleCombinePolicy) pb.SaleCombinePolicy {
+	for message, p := range saleCombinePolicies {
+		if p == policy {
+			return message
+		}
+	}
+
+	return pb.SaleCombinePolicy_SALE_COMBINE_POLICY_UNSPECIFIED
+}
+
+// ParseSaleCombinePolicy checks a policy name taken from configuration. An empty name means best_of.
+func ParseSaleCombinePolicy(name string) (models.SaleCombinePolicy, error) {
+	if name == "" {
+		return models.SaleCombineBestOf, nil
+	}
+	for _, policy := range saleCombinePolicies {
+		if string(policy) == name {
+			return policy, nil
+		}
+	}
+
+	return "", ErrInvalidCombinePolicy
+}
+
+// percentOf returns percent per cent of an amount of minor units, rounded half up. It is worked
+// out without overflowing on the way and fails only when the result does not fit in an int64.
+func percentOf(amount, percent int64) (int64, error) {
+	result := new(big.Int).Mul(big.NewInt(amount), big.NewInt(percent))
+	result.Add(result, big.NewInt(50)).Quo(result, big.NewInt(100))
+	if !result.IsInt64() {
+		return 0, fmt.Errorf("%w: %d per cent of %d is too large", ErrInvalidMoney, percent, amount)
+	}
+
+	return result.Int64(), nil
+}
+
+// unitDiscount returns the amount a per-unit sale takes off one unit at the given price. The
+// discount never exceeds the price; sales that are not priced per unit take nothing off here.
+func unitDiscount(price int64, sale models.Sale) (int64, error) {
+	var discount int64
+	switch sale.DiscountType {
+	case models.DiscountPercentage, "":
+		var err error
+		if discount, err = percentOf(price, int64(sale.SaleSize)); err != nil {
+			return 0, err
+		}
+	case models.DiscountFixedAmount:
+		discount = sale.Amount.Amount
+	case models.DiscountFixedPrice:
+		discount = price - sale.Amount.Amount
+	}
+
+	return min(max(discount, 0), price), nil
+}
+
+func perUnit(sale models.Sale) bool {
+	return sale.DiscountType != models.DiscountBuyXGetY && sale.DiscountType != models.DiscountBundle
+}
+
+// lineDiscount returns the amount a sale takes off a line of quantity units at the given unit
+// price. Bundle discounts are worked out over the whole basket beforehand and passed in bundles
+// by sale id, as the share of the line product.
+func lineDiscount(price, quantity int64, sale models.Sale, bundles map[string]int64) (int64, error) {
+	switch sale.DiscountType {
+	case models.DiscountBuyXGetY:
+		return quantity / (sale.BuyQuantity + sale.FreeQuantity) * sale.FreeQuantity * price, nil
+	case models.DiscountBundle:
+		return bundles[sale.Id], nil
+	}
+
+	discount, err := unitDiscount(price, sale)
+	if err != nil {
+		return 0, err
+	}
+
+	return discount * quantity, nil
+}
+
+// proportion returns part/whole of an amount, rounded down, without overflowing on the way.
+func proportion(amount, part, whole int64) int64 {
+	result := new(big.Int).Mul(big.NewInt(amount), big.NewInt(part))
+	return result.Quo(result, big.NewInt(whole)).Int64()
+}
+
+// bundleDiscounts works out what every bundle sale takes off the basket: each complete set of
+// one unit of every bundle product is sold for the bundle amount instead of its base price. The
+// discount is spread over the bundle products in proportion to their price, and no product
+// carries more than the value of its units in the sets; the rounding remainder goes to the
+// dearest product that still has room. The result holds the shares by product id, then by sale id.
+// It fails when the value of the bundle sets does not fit in an int64.
+func bundleDiscounts(sales []models.Sale, products map[string]models.Product, quantities map[string]int64) (map[string]map[string]int64, error) {
+	result := map[string]map[string]int64{}
+	book := func(productId, saleId string, discount int64) {
+		if discount <= 0 {
+			return
+		}
+		if result[productId] == nil {
+			result[productId] = map[string]int64{}
+		}
+		result[productId][saleId] += discount
+	}
+
+	for _, sale := range sales {
+		if sale.DiscountType != models.DiscountBundle || len(sale.ProductIds) == 0 || sale.Amount == nil {
+			continue
+		}
+
+		members := append([]string{sale.ProductIds[0]}, sale.BundleProductIds...)
+		sets := quantities[members[0]]
+		var value int64
+		for _, id := range members {
+			sets = min(sets, quantities[id])
+			var err error
+			if value, err = add(value, products[id].Price.Amount); err != nil {
+				return nil, err
+			}
+		}
+		if sets <= 0 || value <= sale.Amount.Amount {
+			continue
+		}
+		if _, err := multiply(value, sets); err != nil {
+			return nil, err
+		}
+
+		// Dearest first, so that the remainder lands where it is the smallest part of the value.
+		slices.SortStableFunc(members, func(a, b string) int {
+			return cmp.Compare(products[b].Price.Amount, products[a].Price.Amount)
+		})
+
+		discount := sets * (value - sale.Amount.Amount)
+		shares := make([]int64, len(members))
+		left := discount
+		for i, id := range members {
+			shares[i] = proportion(discount, products[id].Price.Amount, value)
+			left -= shares[i]
+		}
+		for i, id := range members {
+			extra := min(left, sets*products[id].Price.Amount-shares[i])
+			shares[i] += extra
+			left -= extra
+		}
+		for i, id := range members {
+			book(id, sale.Id, shares[i])
+		}
+	}
+
+	return result, nil
+}
+
+// basketLine is one line of a basket with the sales that may apply to it.
+type basketLine struct {
+	ProductId string
+	VariantId string
+	Base      models.Money
+	Quantity  int64
+	Sales     []models.Sale
+}
+
+// priceBasket prices every line of a basket. A bundle applies to the whole basket or not at all:
+// when a line that carries a share of it takes another sale instead, as best_of and exclusive
+// may decide, the bundle is dropped from every line and the basket is priced again. A bundle
+// share is booked once even when its product is on several lines.
+func priceBasket(lines []basketLine, bundles map[string]map[string]int64, policy models.SaleCombinePolicy) ([]models.PricedLine, error) {
+	for {
+		shares := map[string]map[string]int64{}
+		for id, productShares := range bundles {
+			shares[id] = maps.Clone(productShares)
+		}
+
+		priced := make([]models.PricedLine, len(lines))
+		lost := map[string]bool{}
+		for i, line := range lines {
+			offered := shares[line.ProductId]
+			var err error
+			if priced[i], err = priceLine(line.Base, line.Quantity, line.Sales, offered, policy); err != nil {
+				return nil, err
+			}
+			priced[i].ProductId = line.ProductId
+			priced[i].VariantId = line.VariantId
+
+			taken := map[string]bool{}
+			for _, applied := range priced[i].Sales {
+				taken[applied.Sale.Id] = true
+			}
+			for saleId := range offered {
+				if taken[saleId] {
+					delete(offered, saleId)
+				} else {
+					lost[saleId] = true
+				}
+			}
+		}
+		if len(lost) == 0 {
+			return priced, nil
+		}
+
+		for _, productShares := range bundles {
+			for saleId := range lost {
+				delete(productShares, saleId)
+			}
+		}
+	}
+}
+
+// priceLine applies the live sales of a product to a line according to the policy. With stack,
+// per-unit sales go first, in sale order, and quantity and bundle deals are then taken off the
+// reduced price. The line total never drops below zero.
+func priceLine(base models.Money, quantity int64, sales []models.Sale, bundles map[string]int64, policy models.SaleCombinePolicy) (models.PricedLine, error) {
+	line := models.PricedLine{
+		BasePrice: base,
+		Quantity:  quantity,
+		Sales:     []models.AppliedSale{},
+	}
+	gross := base.Amount * quantity
+	applied := func(sale models.Sale, discount int64) models.AppliedSale {
+		return models.AppliedSale{Sale: sale, Discount: models.Money{Amount: discount, Currency: base.Currency}}
+	}
+
+	switch policy {
+	case models.SaleCombineStack:
+		price, left := base.Amount, gross
+		for _, sale := range sales {
+			if perUnit(sale) {
+				discount, err := unitDiscount(price, sale)
+				if err != nil {
+					return line, err
+				}
+				price -= discount
+				left -= discount * quantity
+				line.Sales = append(line.Sales, applied(sale, discount*quantity))
+			}
+		}
+		for _, sale := range sales {
+			if !perUnit(sale) {
+				discount, err := lineDiscount(price, quantity, sale, bundles)
+				if err != nil {
+					return line, err
+				}
+				discount = min(discount, left)
+				left -= discount
+				line.Sales = append(line.Sales, applied(sale, discount))
+			}
+		}
+	case models.SaleCombineExclusive:
+		if len(sales) > 0 {
+			discount, err := lineDiscount(base.Amount, quantity, sales[0], bundles)
+			if err != nil {
+				return line, err
+			}
+			line.Sales = append(line.Sales, applied(sales[0], min(discount, gross)))
+		}
+	default:
+		var best *models.AppliedSale
+		for _, sale := range sales {
+			discount, err := lineDiscount(base.Amount, quantity, sale, bundles)
+			if err != nil {
+				return line, err
+			}
+			discount = min(discount, gross)
+			if best == nil || discount > best.Discount.Amount {
+				candidate := applied(sale, discount)
+				best = &candidate
+			}
+		}
+		if best != nil {
+			line.Sales = append(line.Sales, *best)
+		}
+	}
+
+	line.LineTotal = models.Money{Amount: gross, Currency: base.Currency}
+	for _, sale := range line.Sales {
+		line.LineTotal.Amount -= sale.Discount.Amount
+	}
+
+	return line, nil
+}
+
+func pricedLineMessage(line models.PricedLine) *pb.PricedLineMessage {
+	sales := make([]*pb.AppliedSaleMessage, len(line.Sales))
+	for i, applied := range line.Sales {
+		sales[i] = &pb.AppliedSaleMessage{
+			SaleId:       applied.Sale.Id,
+			Name:         applied.Sale.Name,
+			SaleSize:     int32(applied.Sale.SaleSize),
+			Discount:     moneyMessage(applied.Discount),
+			DiscountType: discountTypeMessage(applied.Sale.DiscountType),
+		}
+	}
+
+	return &pb.PricedLineMessage{
+		ProductId: line.ProductId,
+		VariantId: line.VariantId,
+		Quantity:  line.Quantity,
+		BasePrice: moneyMessage(line.BasePrice),
+		Sales:     sales,
+		Discount:  moneyMessage(models.Money{Amount: line.BasePrice.Amount*line.Quantity - line.LineTotal.Amount, Currency: line.BasePrice.Currency}),
+		LineTotal: moneyMessage(line.LineTotal),
+	}
+}
+
+// CalculatePrice prices a single product or a basket with the sales that are live now. A variant
+// line starts from the variant price and takes only the sales that cover the variant; it counts
+// towards the bundles of its product. Channel price lists play no part here.
+func (s saleService) CalculatePrice(ctx context.Context, request *pb.CalculatePriceRequest) (*pb.CalculatePriceResponse, error) {
+	lines := request.GetLines()
+	if request.GetProductId() != "" {
+		first := &pb.PriceLineRequest{ProductId: request.GetProductId(), VariantId: request.GetVariantId(), Quantity: 1}
+		lines = append([]*pb.PriceLineRequest{first}, lines...)
+	}
+	if len(lines) == 0 {
+		return nil, ErrEmptyBasket
+	}
+
+	policy := s.policy
+	if request.GetPolicy() != pb.SaleCombinePolicy_SALE_COMBINE_POLICY_UNSPECIFIED {
+		var ok bool
+		if policy, ok = saleCombinePolicies[request.GetPolicy()]; !ok {
+			return nil, ErrInvalidCombinePolicy
+		}
+	}
+
+	products := map[string]models.Product{}
+	variants := map[string]models.Variant{}
+	quantities := map[string]int64{}
+	for _, line := range lines {
+		if line.GetQuantity() <= 0 {
+			return nil, ErrInvalidQuantity
+		}
+		quantity, err := add(quantities[line.GetProductId()], line.GetQuantity())
+		if err != nil {
+			return nil, err
+		}
+		quantities[line.GetProductId()] = quantity
+		if _, ok := products[line.GetProductId()]; !ok {
+			product, err := sellableProduct(ctx, s.productRepo, line.GetProductId())
+			if err != nil {
+				return nil, err
+			}
+			products[product.Id] = product
+		}
+
+		if line.GetVariantId() == "" {
+			continue
+		}
+		variant, ok := variants[line.GetVariantId()]
+		if !ok {
+			var err error
+			if variant, err = s.variantRepo.GetById(ctx, line.GetVariantId()); err != nil {
+				return nil, err
+			}
+			variants[variant.Id] = variant
+		}
+		if variant.ProductId != line.GetProductId() {
+			return nil, ErrVariantMismatch
+		}
+	}
+
+	productIds := make([]string, 0, len(products))
+	categoryPaths := map[string][]string{}
+	categoryIds := []string{}
+	for id, product := range products {
+		productIds = append(productIds, id)
+
+		path, err := s.categoryPath(ctx, product)
+		if err != nil {
+			return nil, err
+		}
+		categoryPaths[id] = path
+		categoryIds = append(categoryIds, path...)
+	}
+	sales, err := s.repo.GetActiveForProducts(ctx, productIds, uniqueIds(categoryIds), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	bundles, err := bundleDiscounts(sales, products, quantities)
+	if err != nil {
+		return nil, err
+	}
+
+	// A bundle sale also applies to the bundle products that carry a share of its discount.
+	productSales := map[string][]models.Sale{}
+	for id := range products {
+		for _, sale := range sales {
+			if _, ok := bundles[id][sale.Id]; !ok && !sale.AppliesTo(id, categoryPaths[id]) {
+				continue
+			}
+			productSales[id] = append(productSales[id], sale)
+		}
+	}
+
+	result := &pb.CalculatePriceResponse{
+		Lines:  make([]*pb.PricedLineMessage, len(lines)),
+		Policy: saleCombinePolicyMessage(policy),
+	}
+	basket := make([]basketLine, len(lines))
+	for i, request := range lines {
+		product := products[request.GetProductId()]
+		base := product.Price
+		if request.GetVariantId() != "" {
+			base = variants[request.GetVariantId()].EffectivePrice(product)
+		}
+
+		if _, err := multiply(base.Amount, request.GetQuantity()); err != nil {
+			return nil, err
+		}
+
+		lineSales := []models.Sale{}
+		for _, sale := range productSales[product.Id] {
+			if !sale.AppliesToVariant(request.GetVariantId()) {
+				continue
+			}
+			if sale.Amount != nil && sale.Amount.Currency != base.Currency {
+				continue
+			}
+			lineSales = append(lineSales, sale)
+		}
+
+		basket[i] = basketLine{
+			ProductId: product.Id,
+			VariantId: request.GetVariantId(),
+			Base:      base,
+			Quantity:  request.GetQuantity(),
+			Sales:     lineSales,
+		}
+	}
+
+	priced, err := priceBasket(basket, bundles, policy)
+	if err != nil {
+		return nil, err
+	}
+
+	var total models.Money
+	for i, line := range priced {
+		if i == 0 {
+			total.Currency = line.LineTotal.Currency
+		}
+		if line.LineTotal.Currency != total.Currency {
+			return nil, ErrCurrencyMismatch
+		}
+		if total.Amount, err = add(total.Amount, line.LineTotal.Amount); err != nil {
+			return nil, err
+		}
+
+		result.Lines[i] = pricedLineMessage(line)
+	}
+	result.Total = moneyMessage(total)
+
+	return result, nil
+}
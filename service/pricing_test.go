@@ -0,0 +1,347 @@
+package service
+
+import (
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"maps"
+	"math"
+	"testing"
+)
+
+func rub(amount int64) models.Money {
+	return models.Money{Amount: amount, Currency: "RUB"}
+}
+
+func amountOf(amount int64) *models.Money {
+	money := rub(amount)
+	return &money
+}
+
+func TestUnitDiscount(t *testing.T) {
+	tests := []struct {
+		name  string
+		price int64
+		sale  models.Sale
+		want  int64
+	}{
+		{"percentage", 1000, models.Sale{DiscountType: models.DiscountPercentage, SaleSize: 10}, 100},
+		{"percentage rounds half up", 333, models.Sale{DiscountType: models.DiscountPercentage, SaleSize: 15}, 50},
+		{"legacy percentage", 1000, models.Sale{SaleSize: 25}, 250},
+		{"fixed amount", 1000, models.Sale{DiscountType: models.DiscountFixedAmount, Amount: amountOf(300)}, 300},
+		{"fixed amount above price", 200, models.Sale{DiscountType: models.DiscountFixedAmount, Amount: amountOf(300)}, 200},
+		{"fixed price", 1000, models.Sale{DiscountType: models.DiscountFixedPrice, Amount: amountOf(700)}, 300},
+		{"fixed price above price", 1000, models.Sale{DiscountType: models.DiscountFixedPrice, Amount: amountOf(1200)}, 0},
+		{"buy x get y", 1000, models.Sale{DiscountType: models.DiscountBuyXGetY, BuyQuantity: 2, FreeQuantity: 1}, 0},
+		{"bundle", 1000, models.Sale{DiscountType: models.DiscountBundle, Amount: amountOf(100)}, 0},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := unitDiscount(test.price, test.sale)
+			if err != nil {
+				t.Fatalf("unitDiscount(%d) error = %v", test.price, err)
+			}
+			if got != test.want {
+				t.Errorf("unitDiscount(%d) = %d, want %d", test.price, got, test.want)
+			}
+		})
+	}
+}
+
+func TestPercentOf(t *testing.T) {
+	tests := []struct {
+		name    string
+		amount  int64
+		percent int64
+		want    int64
+		err     error
+	}{
+		{"small", 1000, 10, 100, nil},
+		{"rounds half up", 333, 15, 50, nil},
+		{"largest amount", math.MaxInt64, 100, math.MaxInt64, nil},
+		{"large amount", math.MaxInt64, 50, math.MaxInt64/2 + 1, nil},
+		{"overflow", math.MaxInt64, 101, 0, ErrInvalidMoney},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := percentOf(test.amount, test.percent)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("percentOf(%d, %d) error = %v, want %v", test.amount, test.percent, err, test.err)
+			}
+			if got != test.want {
+				t.Errorf("percentOf(%d, %d) = %d, want %d", test.amount, test.percent, got, test.want)
+			}
+		})
+	}
+}
+
+func TestBundleDiscounts(t *testing.T) {
+	products := map[string]models.Product{
+		"a": {Id: "a", Price: rub(100)},
+		"b": {Id: "b", Price: rub(900)},
+		"c": {Id: "c", Price: rub(200)},
+		"d": {Id: "d", Price: rub(400)},
+	}
+	bundle := func(amount int64, anchor string, others ...string) models.Sale {
+		return models.Sale{
+			Id:               "bundle",
+			DiscountType:     models.DiscountBundle,
+			Amount:           amountOf(amount),
+			ProductIds:       []string{anchor},
+			BundleProductIds: others,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		sale       models.Sale
+		quantities map[string]int64
+		want       map[string]map[string]int64
+	}{
+		{
+			name:       "cheap anchor",
+			sale:       bundle(500, "a", "b"),
+			quantities: map[string]int64{"a": 1, "b": 1},
+			want:       map[string]map[string]int64{"a": {"bundle": 50}, "b": {"bundle": 450}},
+		},
+		{
+			name:       "complete sets only",
+			sale:       bundle(500, "a", "b"),
+			quantities: map[string]int64{"a": 3, "b": 2},
+			want:       map[string]map[string]int64{"a": {"bundle": 100}, "b": {"bundle": 900}},
+		},
+		{
+			name:       "rounding goes to the dearest with room",
+			sale:       bundle(1, "a", "c", "d"),
+			quantities: map[string]int64{"a": 1, "c": 1, "d": 1},
+			want:       map[string]map[string]int64{"a": {"bundle": 99}, "c": {"bundle": 200}, "d": {"bundle": 400}},
+		},
+		{
+			name:       "incomplete set",
+			sale:       bundle(500, "a", "b"),
+			quantities: map[string]int64{"a": 1},
+			want:       map[string]map[string]int64{},
+		},
+		{
+			name:       "bundle dearer than its products",
+			sale:       bundle(1000, "a", "b"),
+			quantities: map[string]int64{"a": 1, "b": 1},
+			want:       map[string]map[string]int64{},
+		},
+		{
+			name:       "not a bundle",
+			sale:       models.Sale{Id: "percentage", DiscountType: models.DiscountPercentage, SaleSize: 10, ProductIds: []string{"a"}},
+			quantities: map[string]int64{"a": 1},
+			want:       map[string]map[string]int64{},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := bundleDiscounts([]models.Sale{test.sale}, products, test.quantities)
+			if err != nil {
+				t.Fatalf("bundleDiscounts() error = %v", err)
+			}
+			if !maps.EqualFunc(got, test.want, maps.Equal[map[string]int64]) {
+				t.Errorf("bundleDiscounts() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestPriceLine(t *testing.T) {
+	percentage := models.Sale{Id: "percentage", DiscountType: models.DiscountPercentage, SaleSize: 10}
+	fixedAmount := models.Sale{Id: "fixed_amount", DiscountType: models.DiscountFixedAmount, Amount: amountOf(150)}
+	buyTwoGetOne := models.Sale{Id: "buy_x_get_y", DiscountType: models.DiscountBuyXGetY, BuyQuantity: 2, FreeQuantity: 1}
+	bundle := models.Sale{Id: "bundle", DiscountType: models.DiscountBundle, Amount: amountOf(500)}
+	sales := []models.Sale{percentage, fixedAmount, buyTwoGetOne}
+
+	tests := []struct {
+		name      string
+		base      int64
+		quantity  int64
+		sales     []models.Sale
+		bundles   map[string]int64
+		policy    models.SaleCombinePolicy
+		discounts map[string]int64
+		total     int64
+	}{
+		{
+			name:      "best of",
+			base:      1000,
+			quantity:  3,
+			sales:     sales,
+			policy:    models.SaleCombineBestOf,
+			discounts: map[string]int64{"buy_x_get_y": 1000},
+			total:     2000,
+		},
+		{
+			name:      "exclusive takes the first sale",
+			base:      1000,
+			quantity:  3,
+			sales:     sales,
+			policy:    models.SaleCombineExclusive,
+			discounts: map[string]int64{"percentage": 300},
+			total:     2700,
+		},
+		{
+			name:      "stack applies per-unit sales first",
+			base:      1000,
+			quantity:  3,
+			sales:     []models.Sale{buyTwoGetOne, percentage, fixedAmount},
+			policy:    models.SaleCombineStack,
+			discounts: map[string]int64{"percentage": 300, "fixed_amount": 450, "buy_x_get_y": 750},
+			total:     1500,
+		},
+		{
+			name:     "stack never goes below zero",
+			base:     1000,
+			quantity: 1,
+			sales: []models.Sale{
+				{Id: "first", DiscountType: models.DiscountFixedAmount, Amount: amountOf(800)},
+				{Id: "second", DiscountType: models.DiscountFixedAmount, Amount: amountOf(800)},
+			},
+			policy:    models.SaleCombineStack,
+			discounts: map[string]int64{"first": 800, "second": 200},
+			total:     0,
+		},
+		{
+			name:      "best of takes the bundle share",
+			base:      900,
+			quantity:  1,
+			sales:     []models.Sale{percentage, bundle},
+			bundles:   map[string]int64{"bundle": 450},
+			policy:    models.SaleCombineBestOf,
+			discounts: map[string]int64{"bundle": 450},
+			total:     450,
+		},
+		{
+			name:      "stack caps the bundle share at what is left",
+			base:      100,
+			quantity:  1,
+			sales:     []models.Sale{bundle, {Id: "fixed_price", DiscountType: models.DiscountFixedPrice, Amount: amountOf(20)}},
+			bundles:   map[string]int64{"bundle": 50},
+			policy:    models.SaleCombineStack,
+			discounts: map[string]int64{"fixed_price": 80, "bundle": 20},
+			total:     0,
+		},
+		{
+			name:      "no sales",
+			base:      1000,
+			quantity:  2,
+			policy:    models.SaleCombineBestOf,
+			discounts: map[string]int64{},
+			total:     2000,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			line, err := priceLine(rub(test.base), test.quantity, test.sales, test.bundles, test.policy)
+			if err != nil {
+				t.Fatalf("priceLine() error = %v", err)
+			}
+
+			discounts := map[string]int64{}
+			for _, applied := range line.Sales {
+				discounts[applied.Sale.Id] = applied.Discount.Amount
+			}
+			if !maps.Equal(discounts, test.discounts) {
+				t.Errorf("discounts = %v, want %v", discounts, test.discounts)
+			}
+			if line.LineTotal != rub(test.total) {
+				t.Errorf("line total = %v, want %v", line.LineTotal, rub(test.total))
+			}
+		})
+	}
+}
+
+// TestBundlePricedOverLines checks that a bundle costs its amount however its value is split
+// between the anchor and the other products.
+func TestBundlePricedOverLines(t *testing.T) {
+	products := map[string]models.Product{
+		"a": {Id: "a", Price: rub(100)},
+		"b": {Id: "b", Price: rub(900)},
+	}
+	sale := models.Sale{
+		Id:               "bundle",
+		DiscountType:     models.DiscountBundle,
+		Amount:           amountOf(500),
+		ProductIds:       []string{"a"},
+		BundleProductIds: []string{"b"},
+	}
+	bundles, err := bundleDiscounts([]models.Sale{sale}, products, map[string]int64{"a": 1, "b": 1})
+	if err != nil {
+		t.Fatalf("bundleDiscounts() error = %v", err)
+	}
+
+	for _, policy := range []models.SaleCombinePolicy{models.SaleCombineBestOf, models.SaleCombineStack, models.SaleCombineExclusive} {
+		var total int64
+		for _, id := range []string{"a", "b"} {
+			line, err := priceLine(products[id].Price, 1, []models.Sale{sale}, bundles[id], policy)
+			if err != nil {
+				t.Fatalf("%s: priceLine() error = %v", policy, err)
+			}
+			total += line.LineTotal.Amount
+		}
+		if total != 500 {
+			t.Errorf("%s: basket total = %d, want 500", policy, total)
+		}
+	}
+}
+
+// TestPriceBasketDropsLostBundles checks that a bundle is taken off every line or none: when
+// another sale beats the bundle on one of its lines, the other lines lose their shares too.
+func TestPriceBasketDropsLostBundles(t *testing.T) {
+	products := map[string]models.Product{
+		"a": {Id: "a", Price: rub(100)},
+		"b": {Id: "b", Price: rub(900)},
+	}
+	bundle := models.Sale{
+		Id:               "bundle",
+		DiscountType:     models.DiscountBundle,
+		Amount:           amountOf(500),
+		ProductIds:       []string{"a"},
+		BundleProductIds: []string{"b"},
+	}
+	percentage := models.Sale{Id: "percentage", DiscountType: models.DiscountPercentage, SaleSize: 60}
+
+	tests := []struct {
+		name   string
+		sales  []models.Sale
+		policy models.SaleCombinePolicy
+		totals map[string]int64
+	}{
+		{"best of, percentage beats the bundle share", []models.Sale{bundle, percentage}, models.SaleCombineBestOf, map[string]int64{"a": 40, "b": 900}},
+		{"exclusive, percentage comes first", []models.Sale{percentage, bundle}, models.SaleCombineExclusive, map[string]int64{"a": 40, "b": 900}},
+		{"exclusive, bundle comes first", []models.Sale{bundle, percentage}, models.SaleCombineExclusive, map[string]int64{"a": 50, "b": 450}},
+		{"stack keeps both", []models.Sale{bundle, percentage}, models.SaleCombineStack, map[string]int64{"a": 0, "b": 450}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			bundles, err := bundleDiscounts([]models.Sale{bundle}, products, map[string]int64{"a": 1, "b": 1})
+			if err != nil {
+				t.Fatalf("bundleDiscounts() error = %v", err)
+			}
+			lines := []basketLine{
+				{ProductId: "a", Base: products["a"].Price, Quantity: 1, Sales: test.sales},
+				{ProductId: "b", Base: products["b"].Price, Quantity: 1, Sales: []models.Sale{bundle}},
+			}
+
+			priced, err := priceBasket(lines, bundles, test.policy)
+			if err != nil {
+				t.Fatalf("priceBasket() error = %v", err)
+			}
+
+			totals := map[string]int64{}
+			for _, line := range priced {
+				totals[line.ProductId] = line.LineTotal.Amount
+			}
+			if !maps.Equal(totals, test.totals) {
+				t.Errorf("line totals = %v, want %v", totals, test.totals)
+			}
+		})
+	}
+}
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"github.com/google/uuid"
 	"github.com/igntnk/stocky_iims/models"
 	"github.com/igntnk/stocky_iims/proto/pb"
 	"github.com/igntnk/stocky_iims/repository"
 	"github.com/rs/zerolog"
-	"strconv"
+	"strings"
 	"time"
 )
 
@@ -15,30 +18,206 @@ type ProductService interface {
 	Get(context.Context, *pb.GetProductsRequest) (*pb.GetProductsResponse, error)
 	GetById(context.Context, *pb.GetByIdProductRequest) (*pb.GetProductMessage, error)
 	GetByProductCode(context.Context, *pb.GetByProductCodeRequest) (*pb.GetProductMessage, error)
+	GetByBarcode(context.Context, *pb.GetByBarcodeRequest) (*pb.GetProductMessage, error)
+	SearchProducts(context.Context, *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error)
 	Delete(context.Context, *pb.DeleteProductRequest) error
+	Restore(context.Context, *pb.RestoreProductRequest) error
+	Purge(context.Context, *pb.PurgeProductRequest) error
 	Update(context.Context, *pb.UpdateProductRequest) error
 	BlockProduct(context.Context, *pb.BlockProductOperationMessage) error
 	UnblockProduct(context.Context, *pb.BlockProductOperationMessage) error
+	GetAvailability(context.Context, *pb.GetAvailabilityRequest) (*pb.ProductAvailabilityMessage, error)
+	InsertVariant(context.Context, *pb.InsertVariantRequest) (*pb.InsertVariantResponse, error)
+	UpdateVariant(context.Context, *pb.UpdateVariantRequest) error
+	DeleteVariant(context.Context, *pb.DeleteVariantRequest) error
+	SchedulePriceChange(context.Context, *pb.SchedulePriceChangeRequest) (*pb.PriceChangeMessage, error)
+	GetPriceAt(context.Context, *pb.GetPriceAtRequest) (*pb.PriceChangeMessage, error)
 }
 
 type productService struct {
-	Logger zerolog.Logger
-	repo   repository.ProductRepository
+	Logger        zerolog.Logger
+	repo          repository.ProductRepository
+	stockRepo     repository.StockRepository
+	categoryRepo  repository.CategoryRepository
+	variantRepo   repository.VariantRepository
+	attributeRepo repository.AttributeRepository
+	priceRepo     repository.PriceRepository
+	saleRepo      repository.SaleRepository
+	tx            repository.Transactor
+	deletePolicy  models.ProductDeletePolicy
+	purger        productPurger
 }
 
-func NewProductService(logger zerolog.Logger, repo repository.ProductRepository) ProductService {
+func NewProductService(logger zerolog.Logger, repo repository.ProductRepository, stockRepo repository.StockRepository, categoryRepo repository.CategoryRepository, variantRepo repository.VariantRepository, attributeRepo repository.AttributeRepository, priceRepo repository.PriceRepository, saleRepo repository.SaleRepository, tx repository.Transactor, deletePolicy models.ProductDeletePolicy) ProductService {
 	return &productService{
-		Logger: logger,
-		repo:   repo,
+		Logger:        logger,
+		repo:          repo,
+		stockRepo:     stockRepo,
+		categoryRepo:  categoryRepo,
+		variantRepo:   variantRepo,
+		attributeRepo: attributeRepo,
+		priceRepo:     priceRepo,
+		saleRepo:      saleRepo,
+		tx:            tx,
+		deletePolicy:  deletePolicy,
+		purger:        newProductPurger(logger, repo, saleRepo, tx, deletePolicy),
 	}
 }
 
+var productDeletePolicies = []models.ProductDeletePolicy{
+	models.ProductDeleteRestrict,
+	models.ProductDeleteBlockSales,
+	models.ProductDeleteSales,
+}
+
+// ParseProductDeletePolicy checks a policy name taken from configuration. An empty name means restrict.
+func ParseProductDeletePolicy(name string) (models.ProductDeletePolicy, error) {
+	if name == "" {
+		return models.ProductDeleteRestrict, nil
+	}
+	for _, policy := range productDeletePolicies {
+		if string(policy) == name {
+			return policy, nil
+		}
+	}
+
+	return "", ErrInvalidDeletePolicy
+}
+
+// creationDateMessage formats a creation date as RFC 3339, leaving an unknown one empty.
+func creationDateMessage(creationDate time.Time) string {
+	if creationDate.IsZero() {
+		return ""
+	}
+
+	return creationDate.UTC().Format(time.RFC3339)
+}
+
+func productMessage(product models.Product) *pb.GetProductMessage {
+	return &pb.GetProductMessage{
+		Id:           product.Id,
+		ProductCode:  product.ProductCode,
+		Barcodes:     product.Barcodes,
+		CategoryId:   product.CategoryId,
+		Attributes:   attributeStrings(product.Attributes),
+		Name:         product.Name,
+		Description:  product.Description,
+		CreationDate: creationDateMessage(product.CreationDate),
+		Price:        moneyMessage(product.Price),
+		DeletedAt:    optionalTimestamp(product.DeletedAt),
+
+		Blocked:       product.Blocked,
+		BlockedBy:     product.BlockedBy,
+		BlockedAt:     optionalTimestamp(product.BlockedAt),
+		BlockedReason: product.BlockedReason,
+	}
+}
+
+func variantMessage(variant models.Variant, product models.Product) *pb.VariantMessage {
+	message := &pb.VariantMessage{
+		Id:             variant.Id,
+		ProductId:      variant.ProductId,
+		ProductCode:    variant.ProductCode,
+		Attributes:     variant.Attributes,
+		Barcode:        variant.Barcode,
+		EffectivePrice: moneyMessage(variant.EffectivePrice(product)),
+	}
+	if variant.Price != nil {
+		message.Price = moneyMessage(*variant.Price)
+	}
+
+	return message
+}
+
+// productWithVariants builds the message of a product together with all of its variants.
+func (p productService) productWithVariants(ctx context.Context, product models.Product) (*pb.GetProductMessage, error) {
+	variants, err := p.variantRepo.GetByProduct(ctx, product.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	message := productMessage(product)
+	message.Variants = make([]*pb.VariantMessage, len(variants))
+	for i, variant := range variants {
+		message.Variants[i] = variantMessage(variant, product)
+	}
+
+	return message, nil
+}
+
+// variantProduct resolves a variant found by code or barcode to its parent product, marking
+// which variant matched.
+func (p productService) variantProduct(ctx context.Context, variant models.Variant, includeDeleted bool) (*pb.GetProductMessage, error) {
+	product, err := p.repo.GetById(ctx, variant.ProductId, includeDeleted)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := p.productWithVariants(ctx, product)
+	if err != nil {
+		return nil, err
+	}
+	message.VariantId = variant.Id
+
+	return message, nil
+}
+
+// productAttributes validates attribute values against the schema of the product category.
+// It also fails when the category does not exist.
+func (p productService) productAttributes(ctx context.Context, categoryId string, values map[string]string) (map[string]any, error) {
+	definitions, err := categoryAttributes(ctx, p.attributeRepo, p.categoryRepo, categoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return validateAttributes(definitions, values)
+}
+
 func (p productService) InsertOne(ctx context.Context, request *pb.InsertProductRequest) (*pb.InsertProductResponse, error) {
-	id, err := p.repo.InsertOne(ctx, &models.Product{
-		Name:         request.Name,
-		Description:  request.Description,
-		Price:        float64(request.Price),
-		CreationDate: time.Now().String(),
+	productCode := request.GetProductCode()
+	if productCode == "" {
+		productCode = uuid.NewString()
+	}
+
+	barcodes, err := normalizeBarcodes(request.GetBarcodes())
+	if err != nil {
+		return nil, err
+	}
+	if err = p.checkProductCodes(ctx, productCode, barcodes); err != nil {
+		return nil, err
+	}
+
+	attributes, err := p.productAttributes(ctx, request.GetCategoryId(), request.GetAttributes())
+	if err != nil {
+		return nil, err
+	}
+
+	price, err := money(request.GetPrice())
+	if err != nil {
+		return nil, err
+	}
+
+	// The product and the first entry of its price history are written together.
+	var id string
+	err = p.tx.WithTx(ctx, func(ctx context.Context) error {
+		now := time.Now()
+
+		var err error
+		id, err = p.repo.InsertOne(ctx, &models.Product{
+			ProductCode:  productCode,
+			Barcodes:     barcodes,
+			CategoryId:   request.GetCategoryId(),
+			Attributes:   attributes,
+			Name:         request.Name,
+			Description:  request.Description,
+			Price:        price,
+			CreationDate: now.UTC(),
+		})
+		if err != nil {
+			return err
+		}
+
+		return p.priceRepo.Apply(ctx, &models.PriceChange{ProductId: id, Price: price, EffectiveFrom: now})
 	})
 	if err != nil {
 		return nil, err
@@ -47,80 +226,595 @@ func (p productService) InsertOne(ctx context.Context, request *pb.InsertProduct
 	return &pb.InsertProductResponse{Id: id}, nil
 }
 
+var productSortFields = map[pb.ProductSortField]string{
+	pb.ProductSortField_PRODUCT_SORT_FIELD_NAME:          models.ProductSortName,
+	pb.ProductSortField_PRODUCT_SORT_FIELD_PRICE:         models.ProductSortPrice,
+	pb.ProductSortField_PRODUCT_SORT_FIELD_CREATION_DATE: models.ProductSortCreationDate,
+}
+
+func (p productService) productFilter(ctx context.Context, request *pb.GetProductsRequest) (models.ProductFilter, error) {
+	filter := models.ProductFilter{
+		Attributes:     attributeFilter(request.GetAttributes()),
+		NameContains:   request.GetNameContains(),
+		Blocked:        request.Blocked,
+		SortField:      productSortFields[request.GetSortField()],
+		SortDescending: request.GetSortDirection() == pb.SortDirection_SORT_DIRECTION_DESC,
+		IncludeBlocked: request.GetIncludeBlocked(),
+		IncludeDeleted: request.GetIncludeDeleted(),
+	}
+
+	var err error
+	if filter.MinPrice, err = optionalMoney(request.GetMinPrice()); err != nil {
+		return filter, err
+	}
+	if filter.MaxPrice, err = optionalMoney(request.GetMaxPrice()); err != nil {
+		return filter, err
+	}
+	if filter.MinPrice != nil && filter.MaxPrice != nil && filter.MinPrice.Currency != filter.MaxPrice.Currency {
+		return filter, ErrCurrencyMismatch
+	}
+	if request.GetCreatedFrom() != nil {
+		createdFrom := request.GetCreatedFrom().AsTime()
+		filter.CreatedFrom = &createdFrom
+	}
+	if request.GetCreatedTo() != nil {
+		createdTo := request.GetCreatedTo().AsTime()
+		filter.CreatedTo = &createdTo
+	}
+
+	if request.GetCategoryId() != "" {
+		categories, err := p.categoryRepo.GetSubtree(ctx, request.GetCategoryId())
+		if err != nil {
+			return filter, err
+		}
+
+		filter.CategoryIds = make([]string, len(categories))
+		for i, category := range categories {
+			filter.CategoryIds[i] = category.Id
+		}
+	}
+
+	return filter, p.checkAttributeFilter(ctx, request.GetCategoryId(), filter.CategoryIds, request.GetAttributes())
+}
+
+// checkAttributeFilter makes sure the requested attribute names can be matched. Within a category
+// they must be defined for it, its ancestors or its subcategories.
+func (p productService) checkAttributeFilter(ctx context.Context, categoryId string, subtree []string, values map[string]string) error {
+	for name := range values {
+		if !validAttributeName(name) {
+			return fmt.Errorf("%w: name %q", ErrInvalidAttribute, name)
+		}
+	}
+	if categoryId == "" || len(values) == 0 {
+		return nil
+	}
+
+	definitions, err := categoryAttributes(ctx, p.attributeRepo, p.categoryRepo, categoryId)
+	if err != nil {
+		return err
+	}
+	below, err := p.attributeRepo.GetByCategories(ctx, subtree)
+	if err != nil {
+		return err
+	}
+	definitions = append(definitions, below...)
+
+	for name := range values {
+		if !definesAttribute(definitions, name) {
+			return fmt.Errorf("%w: %q is not defined for the category", ErrInvalidAttribute, name)
+		}
+	}
+
+	return nil
+}
+
 func (p productService) Get(ctx context.Context, request *pb.GetProductsRequest) (*pb.GetProductsResponse, error) {
-	products, err := p.repo.Get(ctx, request.GetLimit(), request.GetOffset())
+	filter, err := p.productFilter(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+
+	products, page, err := p.repo.Get(ctx, filter, models.PageRequest{
+		Limit:        request.GetLimit(),
+		Offset:       request.GetOffset(),
+		Token:        request.GetPageToken(),
+		IncludeTotal: request.GetIncludeTotalCount(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
 	productsMessage := []*pb.GetProductMessage{}
 	for _, product := range products {
-		price := strconv.FormatFloat(product.Price, 'f', -1, 64)
-
-		productsMessage = append(productsMessage, &pb.GetProductMessage{
-			Id:           product.Id,
-			Name:         product.Name,
-			Description:  product.Description,
-			CreationDate: product.CreationDate,
-			Price:        price,
-		})
+		productsMessage = append(productsMessage, productMessage(product))
 	}
 
 	return &pb.GetProductsResponse{
-		Products: productsMessage,
+		Products:      productsMessage,
+		NextPageToken: page.NextToken,
+		TotalCount:    page.TotalCount,
 	}, nil
 }
 
 func (p productService) GetById(ctx context.Context, request *pb.GetByIdProductRequest) (*pb.GetProductMessage, error) {
-	res, err := p.repo.GetById(ctx, request.GetId())
+	res, err := p.repo.GetById(ctx, request.GetId(), request.GetIncludeDeleted())
 	if err != nil {
 		return nil, err
 	}
-	price := strconv.FormatFloat(res.Price, 'f', -1, 64)
 
-	return &pb.GetProductMessage{
-		Id:           res.Id,
-		Name:         res.Name,
-		Description:  res.Description,
-		CreationDate: res.CreationDate,
-		Price:        price,
-	}, nil
+	return p.productWithVariants(ctx, res)
 }
 
 func (p productService) GetByProductCode(ctx context.Context, request *pb.GetByProductCodeRequest) (*pb.GetProductMessage, error) {
-	res, err := p.repo.GetByProductCode(ctx, request.GetCode())
+	res, err := p.repo.GetByProductCode(ctx, request.GetCode(), request.GetIncludeDeleted())
+	if errors.Is(err, repository.ErrEntityNotFound) {
+		variant, err := p.variantRepo.GetByProductCode(ctx, request.GetCode())
+		if err != nil {
+			return nil, err
+		}
+
+		return p.variantProduct(ctx, variant, request.GetIncludeDeleted())
+	}
 	if err != nil {
 		return nil, err
 	}
-	price := strconv.FormatFloat(res.Price, 'f', -1, 64)
 
-	return &pb.GetProductMessage{
-		Id:           res.Id,
-		Name:         res.Name,
-		Description:  res.Description,
-		CreationDate: res.CreationDate,
-		Price:        price,
+	return p.productWithVariants(ctx, res)
+}
+
+func (p productService) GetByBarcode(ctx context.Context, request *pb.GetByBarcodeRequest) (*pb.GetProductMessage, error) {
+	barcode, err := normalizeBarcode(request.GetCode())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := p.repo.GetByBarcode(ctx, barcode)
+	if errors.Is(err, repository.ErrEntityNotFound) {
+		variant, err := p.variantRepo.GetByBarcode(ctx, barcode)
+		if err != nil {
+			return nil, err
+		}
+
+		return p.variantProduct(ctx, variant, false)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return p.productWithVariants(ctx, res)
+}
+
+func (p productService) SearchProducts(ctx context.Context, request *pb.SearchProductsRequest) (*pb.SearchProductsResponse, error) {
+	query := strings.TrimSpace(request.GetQuery())
+	if query == "" {
+		return nil, ErrEmptySearchQuery
+	}
+
+	products, err := p.repo.Search(ctx, query, request.GetIncludeBlocked(), request.GetLimit(), request.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.ScoredProductMessage, len(products))
+	for i, product := range products {
+		results[i] = &pb.ScoredProductMessage{
+			Product: productMessage(product.Product),
+			Score:   product.Score,
+		}
+	}
+
+	return &pb.SearchProductsResponse{
+		Results: results,
 	}, nil
 }
 
+// sellableProduct returns a product that may be priced, offered on a sale or reserved: it must
+// exist and not be blocked.
+func sellableProduct(ctx context.Context, productRepo repository.ProductRepository, id string) (models.Product, error) {
+	product, err := productRepo.GetById(ctx, id, false)
+	if err != nil {
+		return product, err
+	}
+	if product.Blocked {
+		return product, fmt.Errorf("%w: %s", ErrProductBlocked, id)
+	}
+
+	return product, nil
+}
+
+// productPurger removes deleted products for good, for the Purge call and the purge scheduler alike.
+type productPurger struct {
+	Logger       zerolog.Logger
+	repo         repository.ProductRepository
+	saleRepo     repository.SaleRepository
+	tx           repository.Transactor
+	deletePolicy models.ProductDeletePolicy
+}
+
+func newProductPurger(logger zerolog.Logger, repo repository.ProductRepository, saleRepo repository.SaleRepository, tx repository.Transactor, deletePolicy models.ProductDeletePolicy) productPurger {
+	return productPurger{
+		Logger:       logger,
+		repo:         repo,
+		saleRepo:     saleRepo,
+		tx:           tx,
+		deletePolicy: deletePolicy,
+	}
+}
+
+// hasSales tells whether any sale still refers to the product, blocked ones included. Deleted
+// sales count too once includeDeleted is set, since they can be restored.
+func hasSales(ctx context.Context, saleRepo repository.SaleRepository, productId string, includeDeleted bool) (bool, error) {
+	filter := models.SaleFilter{ProductId: productId, IncludeBlocked: true, IncludeDeleted: includeDeleted}
+	sales, _, err := saleRepo.GetForProduct(ctx, filter, models.PageRequest{Limit: 1})
+	if err != nil {
+		return false, err
+	}
+
+	return len(sales) > 0, nil
+}
+
+// purge removes a deleted product with everything kept for it. The sales were dealt with when the
+// product was deleted; under the restrict policy the product is still kept while any sale, a
+// deleted one included, refers to it.
+func (p productPurger) purge(ctx context.Context, id string) error {
+	if p.deletePolicy == models.ProductDeleteRestrict {
+		found, err := hasSales(ctx, p.saleRepo, id, true)
+		if err != nil {
+			return err
+		}
+		if found {
+			return ErrProductHasSales
+		}
+	}
+
+	return p.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := p.repo.Purge(ctx, id); err != nil {
+			return err
+		}
+
+		return p.saleRepo.ForgetProduct(ctx, id)
+	})
+}
+
+// Delete marks a product as deleted, keeping its variants and stock until it is purged. The
+// delete policy decides about the sales that refer to the product, in the same transaction:
+// restrict refuses while live sales do, block_sales blocks them and delete_sales deletes them.
 func (p productService) Delete(ctx context.Context, request *pb.DeleteProductRequest) error {
-	return p.repo.Delete(ctx, request.GetId())
+	id := request.GetId()
+
+	if p.deletePolicy == models.ProductDeleteRestrict {
+		found, err := hasSales(ctx, p.saleRepo, id, false)
+		if err != nil {
+			return err
+		}
+		if found {
+			return ErrProductHasSales
+		}
+	}
+
+	return p.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := p.repo.Delete(ctx, id); err != nil {
+			return err
+		}
+
+		switch p.deletePolicy {
+		case models.ProductDeleteBlockSales:
+			blocked, err := p.saleRepo.BlockForProduct(ctx, id, "product was deleted")
+			if err != nil {
+				return err
+			}
+			p.Logger.Info().Str("product_id", id).Int64("sales", blocked).Msg("Blocked the sales of a deleted product")
+		case models.ProductDeleteSales:
+			deleted, err := p.saleRepo.DeleteForProduct(ctx, id)
+			if err != nil {
+				return err
+			}
+			p.Logger.Info().Str("product_id", id).Int64("sales", deleted).Msg("Deleted the sales of a deleted product")
+		}
+
+		return nil
+	})
+}
+
+// Restore brings a deleted product back and undoes what the delete policy did to its sales,
+// whichever policy is configured now.
+func (p productService) Restore(ctx context.Context, request *pb.RestoreProductRequest) error {
+	id := request.GetId()
+
+	return p.tx.WithTx(ctx, func(ctx context.Context) error {
+		if err := p.repo.Restore(ctx, id); err != nil {
+			return err
+		}
+
+		unblocked, err := p.saleRepo.UnblockForProduct(ctx, id)
+		if err != nil {
+			return err
+		}
+		restored, err := p.saleRepo.RestoreForProduct(ctx, id)
+		if err != nil {
+			return err
+		}
+		if unblocked > 0 || restored > 0 {
+			p.Logger.Info().Str("product_id", id).Int64("unblocked", unblocked).Int64("restored", restored).Msg("Restored the sales of a restored product")
+		}
+
+		return nil
+	})
+}
+
+// Purge removes a deleted product for good. A product that is not deleted is left alone.
+func (p productService) Purge(ctx context.Context, request *pb.PurgeProductRequest) error {
+	product, err := p.repo.GetById(ctx, request.GetId(), true)
+	if err != nil {
+		return err
+	}
+	if product.DeletedAt == nil {
+		return ErrNotDeleted
+	}
+
+	return p.purger.purge(ctx, request.GetId())
 }
 
 func (p productService) Update(ctx context.Context, request *pb.UpdateProductRequest) error {
-	return p.repo.Update(ctx, &models.Product{
-		Id:           request.Id,
-		Name:         request.Name,
-		Description:  request.Description,
-		Price:        float64(request.Price),
-		CreationDate: time.Now().String(),
+	barcodes, err := normalizeBarcodes(request.GetBarcodes())
+	if err != nil {
+		return err
+	}
+	if err = p.checkProductCodes(ctx, request.GetProductCode(), barcodes); err != nil {
+		return err
+	}
+
+	// Attributes are checked against the resulting category; omitted ones keep their stored values
+	// and empty ones are removed.
+	existing, err := p.repo.GetById(ctx, request.GetId(), false)
+	if err != nil {
+		return err
+	}
+
+	categoryId := request.GetCategoryId()
+	if categoryId == "" {
+		categoryId = existing.CategoryId
+	}
+	definitions, err := categoryAttributes(ctx, p.attributeRepo, p.categoryRepo, categoryId)
+	if err != nil {
+		return err
+	}
+	attributes, err := validateAttributes(definitions, mergeAttributes(definitions, existing.Attributes, request.GetAttributes()))
+	if err != nil {
+		return err
+	}
+
+	price, err := optionalMoney(request.GetPrice())
+	if err != nil {
+		return err
+	}
+	// Variant prices, price lists and sale amounts are kept in the product currency.
+	if price != nil && price.Currency != existing.Price.Currency {
+		return ErrCurrencyMismatch
+	}
+
+	// The price goes through the price history and only when the request names one, so that a
+	// scheduled price applied meanwhile is not overwritten. Both writes commit together.
+	return p.tx.WithTx(ctx, func(ctx context.Context) error {
+		if price != nil && *price != existing.Price {
+			err := p.priceRepo.Apply(ctx, &models.PriceChange{ProductId: existing.Id, Price: *price, EffectiveFrom: time.Now()})
+			if err != nil {
+				return err
+			}
+		}
+
+		return p.repo.Update(ctx, &models.Product{
+			Id:          request.Id,
+			ProductCode: request.ProductCode,
+			Barcodes:    barcodes,
+			CategoryId:  request.GetCategoryId(),
+			Attributes:  attributes,
+			Name:        request.Name,
+			Description: request.Description,
+		})
 	})
 }
 
 func (p productService) BlockProduct(ctx context.Context, message *pb.BlockProductOperationMessage) error {
-	return p.repo.BlockProduct(ctx, message.Id)
+	return p.repo.BlockProduct(ctx, message.GetId(), message.GetActor(), message.GetReason())
 }
 
 func (p productService) UnblockProduct(ctx context.Context, message *pb.BlockProductOperationMessage) error {
 	return p.repo.UnblockProduct(ctx, message.Id)
 }
+
+func (p productService) GetAvailability(ctx context.Context, request *pb.GetAvailabilityRequest) (*pb.ProductAvailabilityMessage, error) {
+	items, err := p.stockRepo.GetByProduct(ctx, request.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+
+	result := &pb.ProductAvailabilityMessage{
+		ProductId:  request.GetProductId(),
+		Warehouses: make([]*pb.WarehouseAvailabilityMessage, len(items)),
+	}
+	for i, item := range items {
+		result.Quantity += item.Quantity
+		result.Reserved += item.Reserved
+		result.Available += item.Available()
+		result.InTransit += item.InTransit
+		result.Warehouses[i] = &pb.WarehouseAvailabilityMessage{
+			WarehouseId: item.WarehouseId,
+			VariantId:   item.VariantId,
+			Quantity:    item.Quantity,
+			Reserved:    item.Reserved,
+			Available:   item.Available(),
+			InTransit:   item.InTransit,
+		}
+	}
+
+	return result, nil
+}
+
+// checkProductCodes rejects a product code or barcode that a variant already uses, since lookups
+// by code and barcode search products and variants together.
+func (p productService) checkProductCodes(ctx context.Context, productCode string, barcodes []string) error {
+	if productCode != "" {
+		if _, err := p.variantRepo.GetByProductCode(ctx, productCode); !errors.Is(err, repository.ErrEntityNotFound) {
+			if err == nil {
+				return repository.ErrAlreadyExists
+			}
+			return err
+		}
+	}
+
+	for _, barcode := range barcodes {
+		if _, err := p.variantRepo.GetByBarcode(ctx, barcode); !errors.Is(err, repository.ErrEntityNotFound) {
+			if err == nil {
+				return repository.ErrAlreadyExists
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkVariantCodes rejects a variant code or barcode that a product already uses, since lookups
+// by code and barcode search products and variants together.
+func (p productService) checkVariantCodes(ctx context.Context, productCode, barcode string) error {
+	if productCode != "" {
+		if _, err := p.repo.GetByProductCode(ctx, productCode, true); !errors.Is(err, repository.ErrEntityNotFound) {
+			if err == nil {
+				return repository.ErrAlreadyExists
+			}
+			return err
+		}
+	}
+
+	if barcode == "" {
+		return nil
+	}
+	if _, err := p.repo.GetByBarcode(ctx, barcode); !errors.Is(err, repository.ErrEntityNotFound) {
+		if err == nil {
+			return repository.ErrAlreadyExists
+		}
+		return err
+	}
+
+	return nil
+}
+
+// variantPrice reads the price a variant request names, which must be in the product currency.
+func variantPrice(message *pb.Money, product models.Product) (*models.Money, error) {
+	price, err := optionalMoney(message)
+	if err != nil {
+		return nil, err
+	}
+	if price != nil && price.Currency != product.Price.Currency {
+		return nil, ErrCurrencyMismatch
+	}
+
+	return price, nil
+}
+
+func (p productService) InsertVariant(ctx context.Context, request *pb.InsertVariantRequest) (*pb.InsertVariantResponse, error) {
+	product, err := p.repo.GetById(ctx, request.GetProductId(), false)
+	if err != nil {
+		return nil, err
+	}
+
+	productCode := request.GetProductCode()
+	if productCode == "" {
+		productCode = uuid.NewString()
+	}
+
+	barcode := ""
+	if request.GetBarcode() != "" {
+		normalized, err := normalizeBarcode(request.GetBarcode())
+		if err != nil {
+			return nil, err
+		}
+		barcode = normalized
+	}
+
+	if err := p.checkVariantCodes(ctx, productCode, barcode); err != nil {
+		return nil, err
+	}
+
+	price, err := variantPrice(request.GetPrice(), product)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := p.variantRepo.InsertOne(ctx, &models.Variant{
+		ProductId:   request.GetProductId(),
+		ProductCode: productCode,
+		Attributes:  request.GetAttributes(),
+		Price:       price,
+		Barcode:     barcode,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InsertVariantResponse{Id: id}, nil
+}
+
+func (p productService) UpdateVariant(ctx context.Context, request *pb.UpdateVariantRequest) error {
+	barcode := ""
+	if request.GetBarcode() != "" {
+		normalized, err := normalizeBarcode(request.GetBarcode())
+		if err != nil {
+			return err
+		}
+		barcode = normalized
+	}
+
+	if err := p.checkVariantCodes(ctx, request.GetProductCode(), barcode); err != nil {
+		return err
+	}
+
+	variant, err := p.variantRepo.GetById(ctx, request.GetId())
+	if err != nil {
+		return err
+	}
+	product, err := p.repo.GetById(ctx, variant.ProductId, false)
+	if err != nil {
+		return err
+	}
+	price, err := variantPrice(request.GetPrice(), product)
+	if err != nil {
+		return err
+	}
+
+	return p.variantRepo.Update(ctx, &models.Variant{
+		Id:          request.GetId(),
+		ProductCode: request.GetProductCode(),
+		Attributes:  request.GetAttributes(),
+		Price:       price,
+		Barcode:     barcode,
+	})
+}
+
+// DeleteVariant removes a variant that has no stock left anywhere. Its empty balances go with it
+// in the same transaction.
+func (p productService) DeleteVariant(ctx context.Context, request *pb.DeleteVariantRequest) error {
+	variant, err := p.variantRepo.GetById(ctx, request.GetId())
+	if err != nil {
+		return err
+	}
+
+	return p.tx.WithTx(ctx, func(ctx context.Context) error {
+		items, err := p.stockRepo.GetByProduct(ctx, variant.ProductId)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if item.VariantId == variant.Id && (item.Quantity != 0 || item.Reserved != 0 || item.InTransit != 0) {
+				return ErrVariantHasStock
+			}
+		}
+
+		if err = p.stockRepo.DeleteEmptyForVariant(ctx, variant.Id); err != nil {
+			return err
+		}
+
+		return p.variantRepo.Delete(ctx, variant.Id)
+	})
+}
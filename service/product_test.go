@@ -0,0 +1,36 @@
+package service
+
+import (
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"testing"
+)
+
+func TestVariantPrice(t *testing.T) {
+	product := models.Product{Id: "product", Price: rub(1000)}
+
+	tests := []struct {
+		name    string
+		message *pb.Money
+		want    *models.Money
+		err     error
+	}{
+		{"product price", nil, nil, nil},
+		{"own price", &pb.Money{Amount: 1200, Currency: "RUB"}, amountOf(1200), nil},
+		{"other currency", &pb.Money{Amount: 1200, Currency: "USD"}, nil, ErrCurrencyMismatch},
+		{"negative amount", &pb.Money{Amount: -1, Currency: "RUB"}, nil, ErrInvalidMoney},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := variantPrice(test.message, product)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("variantPrice() error = %v, want %v", err, test.err)
+			}
+			if (got == nil) != (test.want == nil) || (got != nil && *got != *test.want) {
+				t.Errorf("variantPrice() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
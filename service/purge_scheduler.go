@@ -0,0 +1,80 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+// PurgeScheduler removes products and sales for good once they have stayed deleted for longer
+// than the retention period. Sales go first, so that under the restrict policy a product whose
+// deleted sales have just been purged can go in the same run.
+type PurgeScheduler struct {
+	Logger    zerolog.Logger
+	saleRepo  repository.SaleRepository
+	purger    productPurger
+	retention time.Duration
+	interval  time.Duration
+}
+
+func NewPurgeScheduler(logger zerolog.Logger, productRepo repository.ProductRepository, saleRepo repository.SaleRepository, tx repository.Transactor, deletePolicy models.ProductDeletePolicy, retention, interval time.Duration) *PurgeScheduler {
+	logger = logger.With().Str("worker", "purge_scheduler").Logger()
+
+	return &PurgeScheduler{
+		Logger:    logger,
+		saleRepo:  saleRepo,
+		purger:    newProductPurger(logger, productRepo, saleRepo, tx, deletePolicy),
+		retention: retention,
+		interval:  interval,
+	}
+}
+
+// Run purges expired deletions every interval until ctx is cancelled.
+func (s *PurgeScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.purgeExpired(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *PurgeScheduler) purgeExpired(ctx context.Context) {
+	before := time.Now().Add(-s.retention)
+
+	purged, err := s.saleRepo.PurgeDeletedBefore(ctx, before)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("Failed to purge deleted sales")
+	} else if purged > 0 {
+		s.Logger.Info().Int64("sales", purged).Msg("Purged deleted sales")
+	}
+
+	products, err := s.purger.repo.GetDeletedBefore(ctx, before)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("Failed to load deleted products")
+		return
+	}
+
+	for _, product := range products {
+		err := s.purger.purge(ctx, product.Id)
+		if errors.Is(err, ErrProductHasSales) {
+			s.Logger.Warn().Str("product_id", product.Id).Msg("Deleted product is kept while sales refer to it")
+			continue
+		}
+		if err != nil {
+			s.Logger.Error().Err(err).Str("product_id", product.Id).Msg("Failed to purge deleted product")
+			continue
+		}
+
+		s.Logger.Info().Str("product_id", product.Id).Msg("Purged deleted product")
+	}
+}
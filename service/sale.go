@@ -2,40 +2,297 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"github.com/igntnk/stocky_iims/models"
 	"github.com/igntnk/stocky_iims/proto/pb"
 	"github.com/igntnk/stocky_iims/repository"
 	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"slices"
+	"time"
 )
 
 type SaleService interface {
 	InsertOne(context.Context, *pb.InsertSaleRequest) (*pb.InsertSaleResponse, error)
 	Get(context.Context, *pb.GetSalesRequest) (*pb.GetSalesResponse, error)
 	Delete(context.Context, *pb.DeleteSaleRequest) error
+	Restore(context.Context, *pb.RestoreSaleRequest) error
+	Purge(context.Context, *pb.PurgeSaleRequest) error
 	Update(context.Context, *pb.UpdateSaleRequest) error
 	BlockSale(context.Context, *pb.BlockSaleOperationMessage) error
 	UnblockSale(context.Context, *pb.BlockSaleOperationMessage) error
+	CalculatePrice(context.Context, *pb.CalculatePriceRequest) (*pb.CalculatePriceResponse, error)
+	ListSalesForProduct(context.Context, *pb.ListSalesForProductRequest) (*pb.GetSalesResponse, error)
 }
 
 type saleService struct {
-	Logger zerolog.Logger
-	repo   repository.SaleRepository
+	Logger       zerolog.Logger
+	repo         repository.SaleRepository
+	productRepo  repository.ProductRepository
+	variantRepo  repository.VariantRepository
+	categoryRepo repository.CategoryRepository
+	policy       models.SaleCombinePolicy
 }
 
-func NewSaleService(logger zerolog.Logger, repo repository.SaleRepository) SaleService {
+func NewSaleService(logger zerolog.Logger, repo repository.SaleRepository, productRepo repository.ProductRepository, variantRepo repository.VariantRepository, categoryRepo repository.CategoryRepository, policy models.SaleCombinePolicy) SaleService {
 	return &saleService{
-		Logger: logger,
-		repo:   repo,
+		Logger:       logger,
+		repo:         repo,
+		productRepo:  productRepo,
+		variantRepo:  variantRepo,
+		categoryRepo: categoryRepo,
+		policy:       policy,
 	}
 }
 
+// categoryPath returns the ids of the category of a product and of all its parents.
+func (s saleService) categoryPath(ctx context.Context, product models.Product) ([]string, error) {
+	if product.CategoryId == "" {
+		return nil, nil
+	}
+
+	category, err := s.categoryRepo.GetById(ctx, product.CategoryId)
+	if err != nil {
+		return nil, err
+	}
+
+	return category.Path(), nil
+}
+
+var saleStatuses = map[models.SaleStatus]pb.SaleStatus{
+	models.SaleStatusScheduled: pb.SaleStatus_SALE_STATUS_SCHEDULED,
+	models.SaleStatusActive:    pb.SaleStatus_SALE_STATUS_ACTIVE,
+	models.SaleStatusExpired:   pb.SaleStatus_SALE_STATUS_EXPIRED,
+}
+
+var discountTypes = map[pb.DiscountType]models.DiscountType{
+	pb.DiscountType_DISCOUNT_TYPE_PERCENTAGE:   models.DiscountPercentage,
+	pb.DiscountType_DISCOUNT_TYPE_FIXED_AMOUNT: models.DiscountFixedAmount,
+	pb.DiscountType_DISCOUNT_TYPE_FIXED_PRICE:  models.DiscountFixedPrice,
+	pb.DiscountType_DISCOUNT_TYPE_BUY_X_GET_Y:  models.DiscountBuyXGetY,
+	pb.DiscountType_DISCOUNT_TYPE_BUNDLE:       models.DiscountBundle,
+}
+
+func discountTypeMessage(discountType models.DiscountType) pb.DiscountType {
+	for message, t := range discountTypes {
+		if t == discountType {
+			return message
+		}
+	}
+
+	return pb.DiscountType_DISCOUNT_TYPE_UNSPECIFIED
+}
+
+// discountRequest is the part of insert and update requests that describes a discount.
+type discountRequest interface {
+	GetSaleSize() int32
+	GetDiscountType() pb.DiscountType
+	GetAmount() *pb.Money
+	GetBuyQuantity() int64
+	GetFreeQuantity() int64
+	GetBundleProducts() []string
+}
+
+var saleTargets = map[pb.SaleTarget]models.SaleTarget{
+	pb.SaleTarget_SALE_TARGET_PRODUCTS:   models.SaleTargetProducts,
+	pb.SaleTarget_SALE_TARGET_CATEGORIES: models.SaleTargetCategories,
+	pb.SaleTarget_SALE_TARGET_ALL:        models.SaleTargetAll,
+}
+
+func saleTargetMessage(target models.SaleTarget) pb.SaleTarget {
+	for message, t := range saleTargets {
+		if t == target {
+			return message
+		}
+	}
+
+	return pb.SaleTarget_SALE_TARGET_UNSPECIFIED
+}
+
+// uniqueIds drops empty and repeated ids, keeping the order.
+func uniqueIds(ids []string) []string {
+	result := []string{}
+	for _, id := range ids {
+		if id != "" && !slices.Contains(result, id) {
+			result = append(result, id)
+		}
+	}
+
+	return result
+}
+
+// saleTarget applies the requested targeting to a sale. Every listed product and category
+// must exist, and target products must not be blocked. Variants may only narrow a product sale
+// and must belong to its products.
+func (s saleService) saleTarget(ctx context.Context, sale *models.Sale, request *pb.InsertSaleRequest) error {
+	target := models.SaleTargetProducts
+	if request.GetTarget() != pb.SaleTarget_SALE_TARGET_UNSPECIFIED {
+		var ok bool
+		if target, ok = saleTargets[request.GetTarget()]; !ok {
+			return fmt.Errorf("%w: unknown target", ErrInvalidSaleTarget)
+		}
+	}
+	sale.Target = target
+
+	switch target {
+	case models.SaleTargetProducts:
+		if len(request.GetExcludedProducts()) > 0 {
+			return fmt.Errorf("%w: only category and catalogue-wide sales have exclusions", ErrInvalidSaleTarget)
+		}
+		sale.ProductIds = uniqueIds(append([]string{request.GetProduct()}, request.GetProducts()...))
+		if len(sale.ProductIds) == 0 {
+			return fmt.Errorf("%w: no products", ErrInvalidSaleTarget)
+		}
+	case models.SaleTargetCategories:
+		sale.CategoryIds = uniqueIds(request.GetCategories())
+		if len(sale.CategoryIds) == 0 {
+			return fmt.Errorf("%w: no categories", ErrInvalidSaleTarget)
+		}
+		for _, id := range sale.CategoryIds {
+			if _, err := s.categoryRepo.GetById(ctx, id); err != nil {
+				return err
+			}
+		}
+	}
+	if target != models.SaleTargetProducts {
+		sale.ExcludedProductIds = uniqueIds(request.GetExcludedProducts())
+	}
+	sale.VariantIds = uniqueIds(request.GetVariants())
+	if len(sale.VariantIds) > 0 && target != models.SaleTargetProducts {
+		return fmt.Errorf("%w: only product sales can be limited to variants", ErrInvalidSaleTarget)
+	}
+	for _, id := range sale.VariantIds {
+		variant, err := s.variantRepo.GetById(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !slices.Contains(sale.ProductIds, variant.ProductId) {
+			return ErrVariantMismatch
+		}
+	}
+
+	for _, id := range sale.ProductIds {
+		if _, err := sellableProduct(ctx, s.productRepo, id); err != nil {
+			return err
+		}
+	}
+	for _, id := range sale.ExcludedProductIds {
+		if _, err := s.productRepo.GetById(ctx, id, false); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// saleDiscount applies the requested discount to a sale, keeping only the fields its type uses.
+// Amounts must be in the currency of every target product and bundle product. Category and
+// catalogue-wide sales apply only to products priced in the currency of their amount.
+func (s saleService) saleDiscount(ctx context.Context, sale *models.Sale, request discountRequest) error {
+	discountType := models.DiscountPercentage
+	if request.GetDiscountType() != pb.DiscountType_DISCOUNT_TYPE_UNSPECIFIED {
+		var ok bool
+		if discountType, ok = discountTypes[request.GetDiscountType()]; !ok {
+			return fmt.Errorf("%w: unknown discount type", ErrInvalidDiscount)
+		}
+	}
+
+	amount, err := optionalMoney(request.GetAmount())
+	if err != nil {
+		return err
+	}
+
+	sale.DiscountType = discountType
+	sale.SaleSize = int(request.GetSaleSize())
+
+	switch discountType {
+	case models.DiscountPercentage:
+		if sale.SaleSize <= 0 || sale.SaleSize > 100 {
+			return fmt.Errorf("%w: percentage must be between 1 and 100", ErrInvalidDiscount)
+		}
+		return nil
+	case models.DiscountBuyXGetY:
+		if request.GetBuyQuantity() <= 0 || request.GetFreeQuantity() <= 0 {
+			return fmt.Errorf("%w: buy and free quantities must be positive", ErrInvalidDiscount)
+		}
+		sale.BuyQuantity = request.GetBuyQuantity()
+		sale.FreeQuantity = request.GetFreeQuantity()
+		return nil
+	}
+
+	if amount == nil {
+		return fmt.Errorf("%w: amount is missing", ErrInvalidDiscount)
+	}
+	if discountType == models.DiscountFixedAmount && amount.Amount == 0 {
+		return fmt.Errorf("%w: amount must be positive", ErrInvalidDiscount)
+	}
+	sale.Amount = amount
+
+	productIds := slices.Clone(sale.ProductIds)
+	if discountType == models.DiscountBundle {
+		if sale.Target != models.SaleTargetProducts || len(sale.ProductIds) != 1 {
+			return fmt.Errorf("%w: bundle must target exactly one product", ErrInvalidDiscount)
+		}
+		if len(sale.VariantIds) > 0 {
+			return fmt.Errorf("%w: bundle cannot be limited to variants", ErrInvalidDiscount)
+		}
+		if len(request.GetBundleProducts()) == 0 {
+			return fmt.Errorf("%w: bundle has no other products", ErrInvalidDiscount)
+		}
+
+		seen := map[string]bool{sale.ProductIds[0]: true}
+		for _, id := range request.GetBundleProducts() {
+			if seen[id] {
+				return fmt.Errorf("%w: product %s is in the bundle twice", ErrInvalidDiscount, id)
+			}
+			seen[id] = true
+		}
+		sale.BundleProductIds = request.GetBundleProducts()
+		productIds = append(productIds, sale.BundleProductIds...)
+	}
+
+	for _, id := range productIds {
+		product, err := sellableProduct(ctx, s.productRepo, id)
+		if err != nil {
+			return err
+		}
+		if product.Price.Currency != amount.Currency {
+			return ErrCurrencyMismatch
+		}
+	}
+
+	return nil
+}
+
+// saleWindow applies the requested window to a sale and derives its current status.
+func saleWindow(sale *models.Sale, startsAt, endsAt *timestamppb.Timestamp) error {
+	sale.StartsAt = optionalTime(startsAt)
+	sale.EndsAt = optionalTime(endsAt)
+	if sale.StartsAt != nil && sale.EndsAt != nil && !sale.EndsAt.After(*sale.StartsAt) {
+		return ErrInvalidSaleWindow
+	}
+
+	sale.Status = sale.StatusAt(time.Now())
+	return nil
+}
+
 func (s saleService) InsertOne(ctx context.Context, request *pb.InsertSaleRequest) (*pb.InsertSaleResponse, error) {
-	result, err := s.repo.InsertOne(ctx, &models.Sale{
+	sale := models.Sale{
 		Name:        request.Name,
 		Description: request.Description,
-		SaleSize:    int(request.SaleSize),
-		ProductId:   request.Product,
-	})
+	}
+	if err := saleWindow(&sale, request.GetStartsAt(), request.GetEndsAt()); err != nil {
+		return nil, err
+	}
+	if err := s.saleTarget(ctx, &sale, request); err != nil {
+		return nil, err
+	}
+	if err := s.saleDiscount(ctx, &sale, request); err != nil {
+		return nil, err
+	}
+
+	result, err := s.repo.InsertOne(ctx, &sale)
 	if err != nil {
 		return nil, err
 	}
@@ -46,43 +303,187 @@ func (s saleService) InsertOne(ctx context.Context, request *pb.InsertSaleReques
 }
 
 func (s saleService) Get(ctx context.Context, request *pb.GetSalesRequest) (*pb.GetSalesResponse, error) {
-	sales, err := s.repo.Get(ctx, request.GetLimit(), request.GetOffset())
+	filter := models.SaleFilter{
+		ActiveAt:       optionalTime(request.GetActiveAt()),
+		ProductId:      request.GetProductId(),
+		IncludeBlocked: request.GetIncludeBlocked(),
+		IncludeDeleted: request.GetIncludeDeleted(),
+	}
+	if filter.ProductId != "" {
+		product, err := s.productRepo.GetById(ctx, filter.ProductId, filter.IncludeDeleted)
+		if err != nil {
+			return nil, err
+		}
+		if filter.CategoryIds, err = s.categoryPath(ctx, product); err != nil {
+			return nil, err
+		}
+	}
+
+	sales, page, err := s.repo.Get(ctx, filter, models.PageRequest{
+		Limit:        request.GetLimit(),
+		Offset:       request.GetOffset(),
+		Token:        request.GetPageToken(),
+		IncludeTotal: request.GetIncludeTotalCount(),
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	resultSales := make([]*pb.GetSaleMessage, len(sales))
+	return salesResponse(sales, page), nil
+}
+
+// ListSalesForProduct returns the sales that name the product as a target or in a bundle, the
+// ones a product delete policy acts on. The product itself need not exist any more.
+func (s saleService) ListSalesForProduct(ctx context.Context, request *pb.ListSalesForProductRequest) (*pb.GetSalesResponse, error) {
+	filter := models.SaleFilter{
+		ProductId:      request.GetProductId(),
+		IncludeBlocked: request.GetIncludeBlocked(),
+		IncludeDeleted: request.GetIncludeDeleted(),
+	}
 
+	sales, page, err := s.repo.GetForProduct(ctx, filter, models.PageRequest{
+		Limit:        request.GetLimit(),
+		Offset:       request.GetOffset(),
+		Token:        request.GetPageToken(),
+		IncludeTotal: request.GetIncludeTotalCount(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return salesResponse(sales, page), nil
+}
+
+func saleMessage(sale models.Sale) *pb.GetSaleMessage {
+	message := &pb.GetSaleMessage{
+		Id:          sale.Id,
+		Name:        sale.Name,
+		Description: sale.Description,
+		SaleSize:    int32(sale.SaleSize),
+		StartsAt:    optionalTimestamp(sale.StartsAt),
+		EndsAt:      optionalTimestamp(sale.EndsAt),
+		Status:      saleStatuses[sale.StatusAt(time.Now())],
+
+		DiscountType:   discountTypeMessage(sale.DiscountType),
+		BuyQuantity:    sale.BuyQuantity,
+		FreeQuantity:   sale.FreeQuantity,
+		BundleProducts: sale.BundleProductIds,
+
+		Target:           saleTargetMessage(sale.Target),
+		Products:         sale.ProductIds,
+		Categories:       sale.CategoryIds,
+		ExcludedProducts: sale.ExcludedProductIds,
+		Variants:         sale.VariantIds,
+
+		Blocked:       sale.Blocked,
+		BlockedBy:     sale.BlockedBy,
+		BlockedAt:     optionalTimestamp(sale.BlockedAt),
+		BlockedReason: sale.BlockedReason,
+		DeletedAt:     optionalTimestamp(sale.DeletedAt),
+	}
+	if len(sale.ProductIds) == 1 {
+		message.Product = sale.ProductIds[0]
+	}
+	if sale.Amount != nil {
+		message.Amount = moneyMessage(*sale.Amount)
+	}
+
+	return message
+}
+
+func salesResponse(sales []models.Sale, page models.PageInfo) *pb.GetSalesResponse {
+	resultSales := make([]*pb.GetSaleMessage, len(sales))
 	for i, sale := range sales {
-		resultSales[i] = &pb.GetSaleMessage{
-			Id:          sale.Id,
-			Name:        sale.Name,
-			Description: sale.Description,
-			SaleSize:    int32(sale.SaleSize),
-			Product:     sale.ProductId,
-		}
+		resultSales[i] = saleMessage(sale)
 	}
 
 	return &pb.GetSalesResponse{
-		Sales: resultSales,
-	}, nil
+		Sales:         resultSales,
+		NextPageToken: page.NextToken,
+		TotalCount:    page.TotalCount,
+	}
 }
 
 func (s saleService) Delete(ctx context.Context, request *pb.DeleteSaleRequest) error {
 	return s.repo.Delete(ctx, request.GetId())
 }
 
+// Restore brings a deleted sale back as long as the products it targets, bundle products
+// included, are still there. A purged product leaves its bundles and the product sales it was
+// the only target of without anything to apply to.
+func (s saleService) Restore(ctx context.Context, request *pb.RestoreSaleRequest) error {
+	sale, err := s.repo.GetById(ctx, request.GetId(), true)
+	if err != nil {
+		return err
+	}
+	if err = restorableTargets(sale); err != nil {
+		return err
+	}
+	for _, id := range slices.Concat(sale.ProductIds, sale.BundleProductIds) {
+		_, err = s.productRepo.GetById(ctx, id, false)
+		if errors.Is(err, repository.ErrEntityNotFound) {
+			return fmt.Errorf("%w: %s", ErrSaleTargetGone, id)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.repo.Restore(ctx, request.GetId())
+}
+
+// restorableTargets tells whether a sale still has the products its target and discount need.
+func restorableTargets(sale models.Sale) error {
+	if sale.Target == models.SaleTargetProducts && len(sale.ProductIds) == 0 {
+		return fmt.Errorf("%w: no target products left", ErrSaleTargetGone)
+	}
+	if sale.DiscountType == models.DiscountBundle && len(sale.BundleProductIds) == 0 {
+		return fmt.Errorf("%w: no bundle products left", ErrSaleTargetGone)
+	}
+
+	return nil
+}
+
+// Purge removes a deleted sale for good. A sale that is not deleted is left alone.
+func (s saleService) Purge(ctx context.Context, request *pb.PurgeSaleRequest) error {
+	sale, err := s.repo.GetById(ctx, request.GetId(), true)
+	if err != nil {
+		return err
+	}
+	if sale.DeletedAt == nil {
+		return ErrNotDeleted
+	}
+
+	return s.repo.Purge(ctx, request.GetId())
+}
+
 func (s saleService) Update(ctx context.Context, request *pb.UpdateSaleRequest) error {
-	return s.repo.Update(ctx, &models.Sale{
+	existing, err := s.repo.GetById(ctx, request.GetId(), false)
+	if err != nil {
+		return err
+	}
+
+	sale := models.Sale{
 		Id:          request.GetId(),
 		Name:        request.GetName(),
 		Description: request.GetDescription(),
-		SaleSize:    int(request.SaleSize),
-	})
+		Target:      existing.Target,
+		ProductIds:  existing.ProductIds,
+		CategoryIds: existing.CategoryIds,
+		VariantIds:  existing.VariantIds,
+	}
+	if err := saleWindow(&sale, request.GetStartsAt(), request.GetEndsAt()); err != nil {
+		return err
+	}
+	if err := s.saleDiscount(ctx, &sale, request); err != nil {
+		return err
+	}
+
+	return s.repo.Update(ctx, &sale)
 }
 
 func (s saleService) BlockSale(ctx context.Context, message *pb.BlockSaleOperationMessage) error {
-	return s.repo.BlockSale(ctx, message.Id)
+	return s.repo.BlockSale(ctx, message.GetId(), message.GetActor(), message.GetReason())
 }
 
 func (s saleService) UnblockSale(ctx context.Context, message *pb.BlockSaleOperationMessage) error {
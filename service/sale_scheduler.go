@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"time"
+)
+
+// SaleScheduler keeps the status of sales in step with their validity windows, activating
+// sales when they start and expiring them when they end.
+type SaleScheduler struct {
+	Logger   zerolog.Logger
+	repo     repository.SaleRepository
+	interval time.Duration
+}
+
+func NewSaleScheduler(logger zerolog.Logger, repo repository.SaleRepository, interval time.Duration) *SaleScheduler {
+	return &SaleScheduler{
+		Logger:   logger.With().Str("worker", "sale_scheduler").Logger(),
+		repo:     repo,
+		interval: interval,
+	}
+}
+
+// Run updates sale statuses every interval until ctx is cancelled.
+func (s *SaleScheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		s.updateStatuses(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *SaleScheduler) updateStatuses(ctx context.Context) {
+	now := time.Now()
+
+	sales, err := s.repo.GetStatusDue(ctx, now)
+	if err != nil {
+		s.Logger.Error().Err(err).Msg("Failed to load sales with outdated status")
+		return
+	}
+
+	for _, sale := range sales {
+		status := sale.StatusAt(now)
+
+		changed, err := s.repo.SetStatus(ctx, sale.Id, sale.Status, status)
+		if err != nil {
+			s.Logger.Error().Err(err).Str("sale_id", sale.Id).Msg("Failed to update sale status")
+			continue
+		}
+		if !changed {
+			continue
+		}
+
+		s.Logger.Info().
+			Str("sale_id", sale.Id).
+			Str("from", string(sale.Status)).
+			Str("to", string(status)).
+			Msg("Sale status changed")
+	}
+}
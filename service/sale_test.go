@@ -0,0 +1,41 @@
+package service
+
+import (
+	"errors"
+	"github.com/igntnk/stocky_iims/models"
+	"testing"
+)
+
+func TestRestorableTargets(t *testing.T) {
+	tests := []struct {
+		name string
+		sale models.Sale
+		err  error
+	}{
+		{"product sale", models.Sale{Target: models.SaleTargetProducts, ProductIds: []string{"a"}}, nil},
+		{"product sale without products", models.Sale{Target: models.SaleTargetProducts}, ErrSaleTargetGone},
+		{"category sale", models.Sale{Target: models.SaleTargetCategories, CategoryIds: []string{"c"}}, nil},
+		{
+			name: "bundle",
+			sale: models.Sale{Target: models.SaleTargetProducts, DiscountType: models.DiscountBundle, ProductIds: []string{"a"}, BundleProductIds: []string{"b"}},
+		},
+		{
+			name: "bundle without its product",
+			sale: models.Sale{Target: models.SaleTargetProducts, DiscountType: models.DiscountBundle, BundleProductIds: []string{"b"}},
+			err:  ErrSaleTargetGone,
+		},
+		{
+			name: "bundle without other products",
+			sale: models.Sale{Target: models.SaleTargetProducts, DiscountType: models.DiscountBundle, ProductIds: []string{"a"}},
+			err:  ErrSaleTargetGone,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := restorableTargets(test.sale); !errors.Is(err, test.err) {
+				t.Errorf("restorableTargets() error = %v, want %v", err, test.err)
+			}
+		})
+	}
+}
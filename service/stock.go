@@ -0,0 +1,329 @@
+package service
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type StockService interface {
+	GetStock(context.Context, *pb.GetStockRequest) (*pb.StockMessage, error)
+	AdjustStock(context.Context, *pb.AdjustStockRequest) (*pb.StockMessage, error)
+	Reserve(context.Context, *pb.StockReservationRequest) (*pb.StockMessage, error)
+	Release(context.Context, *pb.StockReservationRequest) (*pb.StockMessage, error)
+	ListMovements(context.Context, *pb.ListMovementsRequest) (*pb.ListMovementsResponse, error)
+	RebuildBalances(context.Context, *pb.RebuildBalancesRequest) (*pb.RebuildBalancesResponse, error)
+}
+
+type stockService struct {
+	Logger        zerolog.Logger
+	repo          repository.StockRepository
+	movementRepo  repository.MovementRepository
+	variantRepo   repository.VariantRepository
+	productRepo   repository.ProductRepository
+	warehouseRepo repository.WarehouseRepository
+}
+
+func NewStockService(logger zerolog.Logger, repo repository.StockRepository, movementRepo repository.MovementRepository, variantRepo repository.VariantRepository, productRepo repository.ProductRepository, warehouseRepo repository.WarehouseRepository) StockService {
+	return &stockService{
+		Logger:        logger,
+		repo:          repo,
+		movementRepo:  movementRepo,
+		variantRepo:   variantRepo,
+		productRepo:   productRepo,
+		warehouseRepo: warehouseRepo,
+	}
+}
+
+var movementReasons = map[pb.MovementReason]models.MovementReason{
+	pb.MovementReason_MOVEMENT_REASON_RECEIPT:         models.MovementReasonReceipt,
+	pb.MovementReason_MOVEMENT_REASON_SALE:            models.MovementReasonSale,
+	pb.MovementReason_MOVEMENT_REASON_ADJUSTMENT:      models.MovementReasonAdjustment,
+	pb.MovementReason_MOVEMENT_REASON_TRANSFER:        models.MovementReasonTransfer,
+	pb.MovementReason_MOVEMENT_REASON_WRITE_OFF:       models.MovementReasonWriteOff,
+	pb.MovementReason_MOVEMENT_REASON_OPENING_BALANCE: models.MovementReasonOpeningBalance,
+}
+
+func movementReasonMessage(reason models.MovementReason) pb.MovementReason {
+	for message, r := range movementReasons {
+		if r == reason {
+			return message
+		}
+	}
+
+	return pb.MovementReason_MOVEMENT_REASON_UNSPECIFIED
+}
+
+// movementReason resolves the ledger reason of a manual adjustment. Transfers are booked only by
+// the transfer service, opening balances only by the migration, receipts must add stock and sales
+// and write-offs must remove it.
+func movementReason(reason pb.MovementReason, delta int64) (models.MovementReason, error) {
+	if delta == 0 {
+		return "", ErrInvalidQuantity
+	}
+
+	switch reason {
+	case pb.MovementReason_MOVEMENT_REASON_UNSPECIFIED:
+		return models.MovementReasonAdjustment, nil
+	case pb.MovementReason_MOVEMENT_REASON_TRANSFER, pb.MovementReason_MOVEMENT_REASON_OPENING_BALANCE:
+		return "", ErrInvalidMovementReason
+	case pb.MovementReason_MOVEMENT_REASON_RECEIPT:
+		if delta < 0 {
+			return "", ErrInvalidMovementReason
+		}
+	case pb.MovementReason_MOVEMENT_REASON_SALE, pb.MovementReason_MOVEMENT_REASON_WRITE_OFF:
+		if delta > 0 {
+			return "", ErrInvalidMovementReason
+		}
+	}
+
+	result, ok := movementReasons[reason]
+	if !ok {
+		return "", ErrInvalidMovementReason
+	}
+
+	return result, nil
+}
+
+func stockMessage(item models.StockItem) *pb.StockMessage {
+	message := &pb.StockMessage{
+		ProductId:   item.ProductId,
+		WarehouseId: item.WarehouseId,
+		VariantId:   item.VariantId,
+		Quantity:    item.Quantity,
+		Reserved:    item.Reserved,
+		Available:   item.Available(),
+		InTransit:   item.InTransit,
+	}
+	if !item.UpdatedAt.IsZero() {
+		message.UpdatedAt = timestamppb.New(item.UpdatedAt)
+	}
+
+	return message
+}
+
+// checkWarehouse makes sure stock can be moved into or out of a warehouse: it exists and is not blocked.
+func checkWarehouse(ctx context.Context, warehouseRepo repository.WarehouseRepository, id string) error {
+	warehouse, err := warehouseRepo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	if warehouse.Blocked {
+		return ErrWarehouseBlocked
+	}
+
+	return nil
+}
+
+// checkVariant makes sure a variant, when one is given, belongs to the product.
+func checkVariant(ctx context.Context, variantRepo repository.VariantRepository, productId, variantId string) error {
+	if variantId == "" {
+		return nil
+	}
+
+	variant, err := variantRepo.GetById(ctx, variantId)
+	if err != nil {
+		return err
+	}
+	if variant.ProductId != productId {
+		return ErrVariantMismatch
+	}
+
+	return nil
+}
+
+func (s stockService) stockKey(ctx context.Context, productId, warehouseId, variantId string) (models.StockKey, error) {
+	if err := checkVariant(ctx, s.variantRepo, productId, variantId); err != nil {
+		return models.StockKey{}, err
+	}
+
+	return models.StockKey{ProductId: productId, WarehouseId: warehouseId, VariantId: variantId}, nil
+}
+
+// GetStock returns the balance of one warehouse, or the total over all warehouses
+// when no warehouse is given. Without a variant the total covers the product and all its variants.
+func (s stockService) GetStock(ctx context.Context, request *pb.GetStockRequest) (*pb.StockMessage, error) {
+	key, err := s.stockKey(ctx, request.GetProductId(), request.GetWarehouseId(), request.GetVariantId())
+	if err != nil {
+		return nil, err
+	}
+
+	if request.GetWarehouseId() != "" {
+		item, err := s.repo.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+
+		return stockMessage(item), nil
+	}
+
+	items, err := s.repo.GetByProduct(ctx, request.GetProductId())
+	if err != nil {
+		return nil, err
+	}
+
+	total := models.StockItem{StockKey: models.StockKey{ProductId: request.GetProductId(), VariantId: request.GetVariantId()}}
+	for _, item := range items {
+		if request.GetVariantId() != "" && item.VariantId != request.GetVariantId() {
+			continue
+		}
+		total.Quantity += item.Quantity
+		total.Reserved += item.Reserved
+		total.InTransit += item.InTransit
+		if item.UpdatedAt.After(total.UpdatedAt) {
+			total.UpdatedAt = item.UpdatedAt
+		}
+	}
+
+	return stockMessage(total), nil
+}
+
+// AdjustStock books a manual movement of an existing product. A blocked product may still be
+// counted, but a blocked warehouse takes no movements.
+func (s stockService) AdjustStock(ctx context.Context, request *pb.AdjustStockRequest) (*pb.StockMessage, error) {
+	reason, err := movementReason(request.GetReason(), request.GetDelta())
+	if err != nil {
+		return nil, err
+	}
+	if _, err = s.productRepo.GetById(ctx, request.GetProductId(), false); err != nil {
+		return nil, err
+	}
+	if err = checkWarehouse(ctx, s.warehouseRepo, request.GetWarehouseId()); err != nil {
+		return nil, err
+	}
+
+	key, err := s.stockKey(ctx, request.GetProductId(), request.GetWarehouseId(), request.GetVariantId())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.Adjust(ctx, &models.StockMovement{
+		StockKey: key,
+		Reason:   reason,
+		Delta:    request.GetDelta(),
+		Actor:    request.GetActor(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stockMessage(item), nil
+}
+
+// Reserve holds stock for a sale, so a blocked product or warehouse cannot be reserved from.
+// Releasing is always allowed.
+func (s stockService) Reserve(ctx context.Context, request *pb.StockReservationRequest) (*pb.StockMessage, error) {
+	if request.GetQuantity() <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+	if _, err := sellableProduct(ctx, s.productRepo, request.GetProductId()); err != nil {
+		return nil, err
+	}
+	if err := checkWarehouse(ctx, s.warehouseRepo, request.GetWarehouseId()); err != nil {
+		return nil, err
+	}
+
+	key, err := s.stockKey(ctx, request.GetProductId(), request.GetWarehouseId(), request.GetVariantId())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.Reserve(ctx, key, request.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	return stockMessage(item), nil
+}
+
+func (s stockService) Release(ctx context.Context, request *pb.StockReservationRequest) (*pb.StockMessage, error) {
+	if request.GetQuantity() <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+
+	key, err := s.stockKey(ctx, request.GetProductId(), request.GetWarehouseId(), request.GetVariantId())
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.repo.Release(ctx, key, request.GetQuantity())
+	if err != nil {
+		return nil, err
+	}
+
+	return stockMessage(item), nil
+}
+
+func (s stockService) ListMovements(ctx context.Context, request *pb.ListMovementsRequest) (*pb.ListMovementsResponse, error) {
+	filter := models.MovementFilter{
+		ProductId:   request.GetProductId(),
+		WarehouseId: request.GetWarehouseId(),
+		VariantId:   request.GetVariantId(),
+	}
+	if request.GetFrom() != nil {
+		from := request.GetFrom().AsTime()
+		filter.From = &from
+	}
+	if request.GetTo() != nil {
+		to := request.GetTo().AsTime()
+		filter.To = &to
+	}
+
+	movements, err := s.movementRepo.Get(ctx, filter, request.GetLimit(), request.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.StockMovementMessage, len(movements))
+	for i, movement := range movements {
+		result[i] = &pb.StockMovementMessage{
+			Id:          movement.Id,
+			ProductId:   movement.ProductId,
+			WarehouseId: movement.WarehouseId,
+			VariantId:   movement.VariantId,
+			Reason:      movementReasonMessage(movement.Reason),
+			Delta:       movement.Delta,
+			Actor:       movement.Actor,
+			TransferId:  movement.TransferId,
+			CreatedAt:   timestamppb.New(movement.CreatedAt),
+		}
+	}
+
+	return &pb.ListMovementsResponse{
+		Movements: result,
+	}, nil
+}
+
+func (s stockService) RebuildBalances(ctx context.Context, request *pb.RebuildBalancesRequest) (*pb.RebuildBalancesResponse, error) {
+	drifts, err := s.repo.RebuildBalances(ctx, request.GetProductId(), request.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.BalanceDriftMessage, len(drifts))
+	for i, drift := range drifts {
+		s.Logger.Warn().
+			Str("product_id", drift.ProductId).
+			Str("warehouse_id", drift.WarehouseId).
+			Str("variant_id", drift.VariantId).
+			Int64("recorded", drift.Recorded).
+			Int64("rebuilt", drift.Rebuilt).
+			Msg("Stock balance drift")
+
+		result[i] = &pb.BalanceDriftMessage{
+			ProductId:   drift.ProductId,
+			WarehouseId: drift.WarehouseId,
+			VariantId:   drift.VariantId,
+			Recorded:    drift.Recorded,
+			Rebuilt:     drift.Rebuilt,
+		}
+	}
+
+	return &pb.RebuildBalancesResponse{
+		Drifts:  result,
+		Applied: !request.GetDryRun(),
+	}, nil
+}
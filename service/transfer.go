@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+type TransferService interface {
+	CreateTransfer(context.Context, *pb.CreateTransferRequest) (*pb.TransferMessage, error)
+	ShipTransfer(context.Context, *pb.TransferOperationMessage) (*pb.TransferMessage, error)
+	ReceiveTransfer(context.Context, *pb.TransferOperationMessage) (*pb.TransferMessage, error)
+}
+
+type transferService struct {
+	Logger        zerolog.Logger
+	repo          repository.TransferRepository
+	warehouseRepo repository.WarehouseRepository
+	variantRepo   repository.VariantRepository
+	productRepo   repository.ProductRepository
+}
+
+func NewTransferService(logger zerolog.Logger, repo repository.TransferRepository, warehouseRepo repository.WarehouseRepository, variantRepo repository.VariantRepository, productRepo repository.ProductRepository) TransferService {
+	return &transferService{
+		Logger:        logger,
+		repo:          repo,
+		warehouseRepo: warehouseRepo,
+		variantRepo:   variantRepo,
+		productRepo:   productRepo,
+	}
+}
+
+var transferStatuses = map[models.TransferStatus]pb.TransferStatus{
+	models.TransferStatusCreated:  pb.TransferStatus_TRANSFER_STATUS_CREATED,
+	models.TransferStatusShipped:  pb.TransferStatus_TRANSFER_STATUS_SHIPPED,
+	models.TransferStatusReceived: pb.TransferStatus_TRANSFER_STATUS_RECEIVED,
+}
+
+func transferMessage(transfer models.Transfer) *pb.TransferMessage {
+	message := &pb.TransferMessage{
+		Id:              transfer.Id,
+		ProductId:       transfer.ProductId,
+		VariantId:       transfer.VariantId,
+		FromWarehouseId: transfer.FromWarehouseId,
+		ToWarehouseId:   transfer.ToWarehouseId,
+		Quantity:        transfer.Quantity,
+		Status:          transferStatuses[transfer.Status],
+		CreatedAt:       timestamppb.New(transfer.CreatedAt),
+	}
+	if transfer.ShippedAt != nil {
+		message.ShippedAt = timestamppb.New(*transfer.ShippedAt)
+	}
+	if transfer.ReceivedAt != nil {
+		message.ReceivedAt = timestamppb.New(*transfer.ReceivedAt)
+	}
+
+	return message
+}
+
+// CreateTransfer plans a transfer of an existing product, or of one of its variants, between two
+// warehouses that are not blocked.
+func (t transferService) CreateTransfer(ctx context.Context, request *pb.CreateTransferRequest) (*pb.TransferMessage, error) {
+	if request.GetQuantity() <= 0 {
+		return nil, ErrInvalidQuantity
+	}
+	if request.GetFromWarehouseId() == request.GetToWarehouseId() {
+		return nil, ErrSameWarehouse
+	}
+	for _, id := range []string{request.GetFromWarehouseId(), request.GetToWarehouseId()} {
+		if err := checkWarehouse(ctx, t.warehouseRepo, id); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := t.productRepo.GetById(ctx, request.GetProductId(), false); err != nil {
+		return nil, err
+	}
+	if err := checkVariant(ctx, t.variantRepo, request.GetProductId(), request.GetVariantId()); err != nil {
+		return nil, err
+	}
+
+	transfer := models.Transfer{
+		ProductId:       request.GetProductId(),
+		VariantId:       request.GetVariantId(),
+		FromWarehouseId: request.GetFromWarehouseId(),
+		ToWarehouseId:   request.GetToWarehouseId(),
+		Quantity:        request.GetQuantity(),
+		Status:          models.TransferStatusCreated,
+		CreatedAt:       time.Now(),
+	}
+
+	id, err := t.repo.InsertOne(ctx, &transfer)
+	if err != nil {
+		return nil, err
+	}
+	transfer.Id = id
+
+	return transferMessage(transfer), nil
+}
+
+// checkTransferWarehouses makes sure the warehouses a transfer step moves stock in or out of have
+// not been blocked since the transfer was created.
+func (t transferService) checkTransferWarehouses(ctx context.Context, id string, warehouses func(models.Transfer) []string) error {
+	transfer, err := t.repo.GetById(ctx, id)
+	if err != nil {
+		return err
+	}
+	for _, warehouseId := range warehouses(transfer) {
+		if err = checkWarehouse(ctx, t.warehouseRepo, warehouseId); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ShipTransfer takes the stock out of the source warehouse and books it in transit to the
+// destination, so both must still be open.
+func (t transferService) ShipTransfer(ctx context.Context, message *pb.TransferOperationMessage) (*pb.TransferMessage, error) {
+	err := t.checkTransferWarehouses(ctx, message.GetId(), func(transfer models.Transfer) []string {
+		return []string{transfer.FromWarehouseId, transfer.ToWarehouseId}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := t.repo.Ship(ctx, message.GetId(), message.GetActor())
+	if err != nil {
+		return nil, err
+	}
+
+	return transferMessage(transfer), nil
+}
+
+// ReceiveTransfer books the stock in transit on hand at the destination, which must still be open.
+// The source has already given the stock up.
+func (t transferService) ReceiveTransfer(ctx context.Context, message *pb.TransferOperationMessage) (*pb.TransferMessage, error) {
+	err := t.checkTransferWarehouses(ctx, message.GetId(), func(transfer models.Transfer) []string {
+		return []string{transfer.ToWarehouseId}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := t.repo.Receive(ctx, message.GetId(), message.GetActor())
+	if err != nil {
+		return nil, err
+	}
+
+	return transferMessage(transfer), nil
+}
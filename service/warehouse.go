@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+	"github.com/igntnk/stocky_iims/models"
+	"github.com/igntnk/stocky_iims/proto/pb"
+	"github.com/igntnk/stocky_iims/repository"
+	"github.com/rs/zerolog"
+)
+
+type WarehouseService interface {
+	InsertOne(context.Context, *pb.InsertWarehouseRequest) (*pb.InsertWarehouseResponse, error)
+	Get(context.Context, *pb.GetWarehousesRequest) (*pb.GetWarehousesResponse, error)
+	GetById(context.Context, *pb.GetByIdWarehouseRequest) (*pb.GetWarehouseMessage, error)
+	Delete(context.Context, *pb.DeleteWarehouseRequest) error
+	Update(context.Context, *pb.UpdateWarehouseRequest) error
+	BlockWarehouse(context.Context, *pb.BlockWarehouseOperationMessage) error
+	UnblockWarehouse(context.Context, *pb.BlockWarehouseOperationMessage) error
+}
+
+type warehouseService struct {
+	Logger    zerolog.Logger
+	repo      repository.WarehouseRepository
+	stockRepo repository.StockRepository
+}
+
+func NewWarehouseService(logger zerolog.Logger, repo repository.WarehouseRepository, stockRepo repository.StockRepository) WarehouseService {
+	return &warehouseService{
+		Logger:    logger,
+		repo:      repo,
+		stockRepo: stockRepo,
+	}
+}
+
+func warehouseMessage(warehouse models.Warehouse) *pb.GetWarehouseMessage {
+	return &pb.GetWarehouseMessage{
+		Id:      warehouse.Id,
+		Name:    warehouse.Name,
+		Address: warehouse.Address,
+		Blocked: warehouse.Blocked,
+	}
+}
+
+func (w warehouseService) InsertOne(ctx context.Context, request *pb.InsertWarehouseRequest) (*pb.InsertWarehouseResponse, error) {
+	id, err := w.repo.InsertOne(ctx, &models.Warehouse{
+		Name:    request.GetName(),
+		Address: request.GetAddress(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.InsertWarehouseResponse{Id: id}, nil
+}
+
+func (w warehouseService) Get(ctx context.Context, request *pb.GetWarehousesRequest) (*pb.GetWarehousesResponse, error) {
+	warehouses, err := w.repo.Get(ctx, request.GetLimit(), request.GetOffset())
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*pb.GetWarehouseMessage, len(warehouses))
+	for i, warehouse := range warehouses {
+		result[i] = warehouseMessage(warehouse)
+	}
+
+	return &pb.GetWarehousesResponse{
+		Warehouses: result,
+	}, nil
+}
+
+func (w warehouseService) GetById(ctx context.Context, request *pb.GetByIdWarehouseRequest) (*pb.GetWarehouseMessage, error) {
+	warehouse, err := w.repo.GetById(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return warehouseMessage(warehouse), nil
+}
+
+// Delete removes a warehouse that holds nothing on hand, reserved or in transit.
+func (w warehouseService) Delete(ctx context.Context, request *pb.DeleteWarehouseRequest) error {
+	holds, err := w.stockRepo.HoldsStock(ctx, request.GetId())
+	if err != nil {
+		return err
+	}
+	if holds {
+		return ErrWarehouseHasStock
+	}
+
+	return w.repo.Delete(ctx, request.GetId())
+}
+
+func (w warehouseService) Update(ctx context.Context, request *pb.UpdateWarehouseRequest) error {
+	return w.repo.Update(ctx, &models.Warehouse{
+		Id:      request.GetId(),
+		Name:    request.GetName(),
+		Address: request.GetAddress(),
+	})
+}
+
+func (w warehouseService) BlockWarehouse(ctx context.Context, message *pb.BlockWarehouseOperationMessage) error {
+	return w.repo.BlockWarehouse(ctx, message.GetId())
+}
+
+func (w warehouseService) UnblockWarehouse(ctx context.Context, message *pb.BlockWarehouseOperationMessage) error {
+	return w.repo.UnblockWarehouse(ctx, message.GetId())
+}
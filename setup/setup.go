@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog"
 	"go.mongodb.org/mongo-driver/mongo"
 	"google.golang.org/grpc"
+	"time"
 )
 
 var grpcServer *grpc.Server
@@ -17,18 +18,68 @@ func GRPCServer() *grpc.Server {
 	return grpcServer
 }
 
-func Init(ctx context.Context, db *mongo.Database, isReplicaSet bool, logger zerolog.Logger, cfg *config.Config) error {
+func Init(ctx context.Context, db *mongo.Database, logger zerolog.Logger, cfg *config.Config) error {
+	combinePolicy, err := service.ParseSaleCombinePolicy(cfg.Pricing.SaleCombinePolicy)
+	if err != nil {
+		return err
+	}
+	deletePolicy, err := service.ParseProductDeletePolicy(cfg.Products.DeletePolicy)
+	if err != nil {
+		return err
+	}
+
 	var (
-		saleRepo    = mongorepo.NewSaleRepository(ctx, db, isReplicaSet, logger)
-		productRepo = mongorepo.NewProductRepository(ctx, db, isReplicaSet, logger)
+		saleRepo      = mongorepo.NewSaleRepository(ctx, db, logger)
+		productRepo   = mongorepo.NewProductRepository(ctx, db, logger)
+		stockRepo     = mongorepo.NewStockRepository(ctx, db, logger)
+		warehouseRepo = mongorepo.NewWarehouseRepository(ctx, db, logger)
+		transferRepo  = mongorepo.NewTransferRepository(ctx, db, logger)
+		movementRepo  = mongorepo.NewMovementRepository(ctx, db, logger)
+		categoryRepo  = mongorepo.NewCategoryRepository(ctx, db, logger)
+		variantRepo   = mongorepo.NewVariantRepository(ctx, db, logger)
+		attributeRepo = mongorepo.NewAttributeRepository(ctx, db, logger)
+		priceRepo     = mongorepo.NewPriceRepository(ctx, db, logger)
+		priceListRepo = mongorepo.NewPriceListRepository(ctx, db, logger)
+		couponRepo    = mongorepo.NewCouponRepository(ctx, db, logger)
+		transactor    = mongorepo.NewTransactor(ctx, db, logger)
 
-		saleService    = service.NewSaleService(logger, saleRepo)
-		productService = service.NewProductService(logger, productRepo)
+		saleService      = service.NewSaleService(logger, saleRepo, productRepo, variantRepo, categoryRepo, combinePolicy)
+		productService   = service.NewProductService(logger, productRepo, stockRepo, categoryRepo, variantRepo, attributeRepo, priceRepo, saleRepo, transactor, deletePolicy)
+		stockService     = service.NewStockService(logger, stockRepo, movementRepo, variantRepo, productRepo, warehouseRepo)
+		warehouseService = service.NewWarehouseService(logger, warehouseRepo, stockRepo)
+		transferService  = service.NewTransferService(logger, transferRepo, warehouseRepo, variantRepo, productRepo)
+		categoryService  = service.NewCategoryService(logger, categoryRepo, productRepo, attributeRepo)
+		attributeService = service.NewAttributeService(logger, attributeRepo, categoryRepo)
+		priceListService = service.NewPriceListService(logger, priceListRepo, productRepo)
+		couponService    = service.NewCouponService(logger, couponRepo, saleRepo)
 	)
 
-	grpcServer = grpc.NewServer()
+	go service.NewPriceScheduler(logger, priceRepo, workerInterval(cfg.Server.PriceSchedulerInterval)).Run(ctx)
+	go service.NewSaleScheduler(logger, saleRepo, workerInterval(cfg.Server.SaleSchedulerInterval)).Run(ctx)
+	if cfg.Retention.DeletedDays > 0 {
+		retention := time.Duration(cfg.Retention.DeletedDays) * 24 * time.Hour
+		go service.NewPurgeScheduler(logger, productRepo, saleRepo, transactor, deletePolicy, retention, workerInterval(cfg.Server.PurgeSchedulerInterval)).Run(ctx)
+	}
+
+	grpcServer = grpc.NewServer(grpc.ChainUnaryInterceptor(grpcapp.ErrorInterceptor(), grpcapp.ValidationInterceptor()))
 	grpcapp.RegisterSaleServer(grpcServer, logger, saleService)
 	grpcapp.RegisterProductServer(grpcServer, logger, productService)
+	grpcapp.RegisterStockServer(grpcServer, logger, stockService)
+	grpcapp.RegisterWarehouseServer(grpcServer, logger, warehouseService)
+	grpcapp.RegisterTransferServer(grpcServer, logger, transferService)
+	grpcapp.RegisterCategoryServer(grpcServer, logger, categoryService)
+	grpcapp.RegisterAttributeServer(grpcServer, logger, attributeService)
+	grpcapp.RegisterPriceListServer(grpcServer, logger, priceListService)
+	grpcapp.RegisterCouponServer(grpcServer, logger, couponService)
 
 	return nil
 }
+
+// workerInterval converts a configured interval in seconds, falling back to one minute.
+func workerInterval(seconds int) time.Duration {
+	if seconds <= 0 {
+		return time.Minute
+	}
+
+	return time.Duration(seconds) * time.Second
+}